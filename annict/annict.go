@@ -5,10 +5,14 @@ import (
 	"fmt"
 	"net/http"
 	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/GoodCodingFriends/animekai/errors"
 	"github.com/GoodCodingFriends/animekai/resource"
+	"github.com/GoodCodingFriends/animekai/store"
 	"github.com/Yamashou/gqlgenc/client"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
@@ -28,11 +32,48 @@ type Service interface {
 		cursor string,
 		limit int32,
 	) (_ []*resource.Work, nextCursor string, _ error)
+	// ListAllWorks lists all works in the passed state by following pages up to a bound.
+	// Unlike ListWorks, works have only titles, seasons, episode counts and statuses, and records are not synced.
+	ListAllWorks(ctx context.Context, state StatusState) ([]*resource.Work, error)
+	// SearchWorks searches works by title with the title search of Annict, in order of popularity.
+	// Kana and English titles are not searched, but if some of the found works have exactly the same title, kana
+	// title or English title ignoring spaces and cases, only they are returned.
+	SearchWorks(ctx context.Context, title string, limit int32) ([]*resource.Work, error)
+	// ListRecords lists records created in [since, until) in chronological order.
+	// Records are listed from the local cache, so records older than the cached ones are not listed.
+	ListRecords(ctx context.Context, since, until time.Time) ([]*resource.Record, error)
+	// ListUpcomingPrograms lists programs of watching works which start within the duration from now and broadcast
+	// unwatched episodes, in chronological order.
+	ListUpcomingPrograms(ctx context.Context, within time.Duration) ([]*resource.Program, error)
+	// ListWorkStaffs lists studios, directors, composers and series composition writers of works in the passed state.
+	// A staff having several roles in a work is listed once per role.
+	ListWorkStaffs(ctx context.Context, state StatusState) ([]*resource.Staff, error)
 	// CreateNextEpisodeRecords creates new records according to watching works.
 	// If a created episode is the last episode, CreateNextEpisodeRecords marks the work state as WATCHED.
-	CreateNextEpisodeRecords(ctx context.Context) ([]*resource.Episode, error)
+	// Works to be recorded can be selected by IncludeWorks and ExcludeWorks, and WithEpisodes records several episodes
+	// per work.
+	// CreateNextEpisodeRecords is idempotent within a viewing session: works which are already recorded in the session
+	// are not recorded again. See WithSessionID for details.
+	CreateNextEpisodeRecords(ctx context.Context, opts ...RecordOption) ([]*resource.Episode, error)
+	// UndoRecordSession deletes the records created in the latest record session and reverts works which are marked
+	// as WATCHED in the session to WATCHING. It returns the episodes whose records are deleted.
+	// UndoRecordSession returns errors.NotFound if there is no session to undo.
+	UndoRecordSession(ctx context.Context) ([]*resource.Episode, error)
+	// GetWorkImageURL returns the original image URL of the work identified by workID.
+	// GetWorkImageURL returns an empty string if the work has no images.
+	GetWorkImageURL(ctx context.Context, workID int32) (string, error)
+	// CreateReview creates a review of the work identified by workID. Unspecified ratings are left empty.
+	CreateReview(ctx context.Context, workID int32, review *resource.Review) (*resource.Review, error)
+	// UpdateReview updates the review identified by review's ID. All ratings must be specified.
+	UpdateReview(ctx context.Context, review *resource.Review) (*resource.Review, error)
+	// DeleteReview deletes the review identified by reviewID.
+	DeleteReview(ctx context.Context, reviewID string) error
+	// ListReviews lists reviews written by animekai account in reverse chronological order.
+	// cursor is for paging, empty string if the first page.
+	ListReviews(ctx context.Context, cursor string, limit int32) (_ []*resource.Review, nextCursor string, _ error)
 	// UpdateWorkStatus updates the work identified by work's ID to the passed work state.
-	UpdateWorkStatus(ctx context.Context, id int, state StatusState) error
+	// It doesn't create any records unless RecordFirstEpisode is specified.
+	UpdateWorkStatus(ctx context.Context, id int, state StatusState, opts ...StatusOption) error
 
 	// Stop stops the service.
 	Stop(ctx context.Context) error
@@ -42,10 +83,92 @@ type service struct {
 	client *Client
 
 	ogImageFetcher *ogImageFetcher
+	imageResolver  imageResolver
+
+	maxRecordPages      int
+	recordSyncInterval  time.Duration
+	imageCacheTTL       time.Duration
+	placeholderImageURL string
+	imageProxyURL       string
+	recordSessionWindow time.Duration
+
+	// store is nil if no store is specified by WithStore.
+	store store.Store
+
+	// syncMu serializes syncRecords and guards syncedAt.
+	syncMu sync.Mutex
+	// syncedAt is when records are synced last time. It is the zero time if records need to be synced.
+	syncedAt time.Time
+	records  *recordCache
+
+	// recordMu serializes CreateNextEpisodeRecords.
+	recordMu sync.Mutex
+	sessions *sessionJournal
+}
+
+// Option configures the service instantiated by New.
+type Option func(*service)
+
+// WithMaxRecordPages limits the number of pages which are fetched to list all records.
+// Records older than the last page are ignored. The default value is 100, which is kept if n is less than 1.
+func WithMaxRecordPages(n int) Option {
+	return func(s *service) {
+		if n < 1 {
+			return
+		}
+		s.maxRecordPages = n
+	}
+}
+
+// WithRecordSyncInterval specifies the minimum interval between fetches of new records.
+// Records are listed from the cache without fetching within the interval from the last fetch unless records are
+// created by the service. The default value is 1 minute.
+func WithRecordSyncInterval(d time.Duration) Option {
+	return func(s *service) {
+		s.recordSyncInterval = d
+	}
+}
+
+// WithStore specifies the store which persists cached records and image URLs.
+// If it is not specified, records are cached in memory and image URLs are cached in an in-memory LRU cache only.
+func WithStore(st store.Store) Option {
+	return func(s *service) {
+		s.store = st
+	}
+}
+
+// WithImageCacheTTL specifies how long fetched image URLs are cached. The default value is 24 hours.
+func WithImageCacheTTL(ttl time.Duration) Option {
+	return func(s *service) {
+		s.imageCacheTTL = ttl
+	}
+}
+
+// WithPlaceholderImageURL specifies the image URL which is used for works which have no images.
+func WithPlaceholderImageURL(url string) Option {
+	return func(s *service) {
+		s.placeholderImageURL = url
+	}
 }
 
-func New(token, endpoint string) Service {
-	return &service{
+// WithImageProxyURL makes image URLs of works point at the image proxy served under url
+// instead of the original image URLs. The image URL of a work is url followed by "/<workID>".
+func WithImageProxyURL(url string) Option {
+	return func(s *service) {
+		s.imageProxyURL = strings.TrimSuffix(url, "/")
+	}
+}
+
+// WithRecordSessionWindow specifies the duration in which CreateNextEpisodeRecords calls without session IDs
+// are regarded as the same viewing session. The default value is 10 minutes.
+func WithRecordSessionWindow(d time.Duration) Option {
+	return func(s *service) {
+		s.recordSessionWindow = d
+	}
+}
+
+func New(token, endpoint string, opts ...Option) Service {
+	s := &service{
 		client: &Client{
 			client.NewClient(
 				http.DefaultClient,
@@ -55,8 +178,28 @@ func New(token, endpoint string) Service {
 				},
 			),
 		},
-		ogImageFetcher: newOGImageFetcher(),
+		maxRecordPages:      defaultMaxRecordPages,
+		recordSyncInterval:  defaultRecordSyncInterval,
+		imageCacheTTL:       defaultImageCacheTTL,
+		recordSessionWindow: defaultRecordSessionWindow,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.ogImageFetcher = newOGImageFetcher(newImageCache(s.store, s.imageCacheTTL))
+	s.imageResolver = imageResolverChain{
+		annictImageResolver{},
+		s.ogImageFetcher,
+		placeholderImageResolver(s.placeholderImageURL),
+	}
+	st := s.store
+	if st == nil {
+		st = store.NewMemory()
 	}
+	s.records = newRecordCache(st)
+	s.sessions = newSessionJournal(st)
+	return s
 }
 
 var seasonToKanji = map[SeasonName]string{
@@ -66,6 +209,39 @@ var seasonToKanji = map[SeasonName]string{
 	SeasonNameWinter: "冬",
 }
 
+var seasonToResource = map[SeasonName]resource.Work_Season{
+	SeasonNameWinter: resource.Work_WINTER,
+	SeasonNameSpring: resource.Work_SPRING,
+	SeasonNameSummer: resource.Work_SUMMER,
+	SeasonNameAutumn: resource.Work_AUTUMN,
+}
+
+// setSeason sets the season when w is released. Works which are not released yet may have no seasons.
+func setSeason(w *resource.Work, year *int64, name *SeasonName) {
+	if year == nil || name == nil {
+		return
+	}
+	w.ReleasedOn = fmt.Sprintf("%d %s", *year, seasonToKanji[*name])
+	w.SeasonYear = int32(*year)
+	w.SeasonName = seasonToResource[*name]
+}
+
+func toResourceStatus(s *StatusState) resource.Work_Status {
+	if s == nil {
+		return resource.Work_STATUS_UNSPECIFIED
+	}
+	switch *s {
+	case StatusStateWatching:
+		return resource.Work_WATCHING
+	case StatusStateWatched:
+		return resource.Work_WATCHED
+	case StatusStateStopWatching:
+		return resource.Work_DROPPED
+	default:
+		return resource.Work_STATUS_UNSPECIFIED
+	}
+}
+
 func (s *service) GetProfile(ctx context.Context) (*resource.Profile, error) {
 	res, err := s.client.GetProfile(ctx)
 	if err != nil {
@@ -113,41 +289,26 @@ func (s *service) ListWorks(
 		return nil, "", nil
 	}
 
-	var (
-		works   = make([]*resource.Work, 0, len(edges))
-		records map[string]struct{ BeginTime, FinishTime time.Time }
-	)
+	works := make([]*resource.Work, 0, len(edges))
 
 	var eg errgroup.Group
 	eg.Go(func() error {
-		m, err := s.listRecords(ctx)
-		if err != nil {
+		if err := s.syncRecords(ctx); err != nil {
 			return failure.Wrap(err)
 		}
-
-		records = m
-
 		return nil
 	})
 
 	for _, r := range edges {
 		n := r.Node
 
-		var status resource.Work_Status
-		switch *n.ViewerStatusState {
-		case StatusStateWatching:
-			status = resource.Work_WATCHING
-		case StatusStateWatched:
-			status = resource.Work_WATCHED
-		}
-
 		res := &resource.Work{
 			Id:            int32(n.AnnictID),
 			Title:         n.Title,
-			ReleasedOn:    fmt.Sprintf("%d %s", *n.SeasonYear, seasonToKanji[*n.SeasonName]),
 			EpisodesCount: int32(n.EpisodesCount),
-			Status:        status,
+			Status:        toResourceStatus(n.ViewerStatusState),
 		}
+		setSeason(res, n.SeasonYear, n.SeasonName)
 		if n.OfficialSiteURL != nil {
 			res.OfficialSiteUrl = *n.OfficialSiteURL
 		}
@@ -156,17 +317,22 @@ func (s *service) ListWorks(
 		}
 		works = append(works, res)
 
+		if s.imageProxyURL != "" {
+			res.ImageUrl = fmt.Sprintf("%s/%d", s.imageProxyURL, res.Id)
+			continue
+		}
+
+		img := &workImage{workID: res.Id}
+		if i := n.Image; i != nil {
+			img.urls = imageURLs(i.RecommendedImageURL, i.FacebookOgImageURL, i.TwitterBiggerAvatarURL, i.TwitterAvatarURL)
+		}
 		eg.Go(func() error {
-			doneCh, err := s.ogImageFetcher.process(ctx, res.Id)
+			url, err := s.imageResolver.resolve(ctx, img)
 			if err != nil {
 				return failure.Wrap(err)
 			}
-			select {
-			case <-ctx.Done():
-				return ctx.Err()
-			case res.ImageUrl = <-doneCh:
-				return nil
-			}
+			res.ImageUrl = url
+			return nil
 		})
 	}
 	if err := eg.Wait(); err != nil {
@@ -174,8 +340,17 @@ func (s *service) ListWorks(
 	}
 
 	for i := range works {
-		m, ok := records[works[i].Title]
-		if !ok {
+		n, err := s.watchedEpisodesCount(works[i].Id)
+		if err != nil {
+			return nil, "", failure.Wrap(err)
+		}
+		works[i].WatchedEpisodesCount = int32(n)
+
+		m, err := s.workPeriod(works[i].Id)
+		if err != nil {
+			return nil, "", failure.Wrap(err)
+		}
+		if m == nil {
 			continue
 		}
 
@@ -207,147 +382,788 @@ func (s *service) ListWorks(
 	return works, edges[len(edges)-1].Cursor, nil
 }
 
-var jst = time.FixedZone("Asia/Tokyo", 9*60*60)
+func (s *service) ListAllWorks(ctx context.Context, state StatusState) ([]*resource.Work, error) {
+	var (
+		stateP *StatusState
+		after  *string
+		works  []*resource.Work
+	)
+	if state != StatusStateNoState {
+		stateP = &state
+	}
+
+	for page := 0; ; page++ {
+		if page == maxAllWorksPages {
+			ctxzap.Extract(ctx).Warn("reached the max number of work pages", zap.Int("max_all_works_pages", maxAllWorksPages))
+			break
+		}
+
+		res, err := s.client.ListAllWorks(ctx, stateP, after, allWorksPageSize)
+		if err != nil {
+			return nil, convertError(err)
+		}
+
+		for _, e := range res.Viewer.Works.Edges {
+			n := e.Node
+			w := &resource.Work{
+				Id:            int32(n.AnnictID),
+				Title:         n.Title,
+				EpisodesCount: int32(n.EpisodesCount),
+				Status:        toResourceStatus(n.ViewerStatusState),
+			}
+			setSeason(w, n.SeasonYear, n.SeasonName)
+			works = append(works, w)
+		}
+
+		pageInfo := res.Viewer.Works.PageInfo
+		if !pageInfo.HasNextPage || pageInfo.EndCursor == nil {
+			break
+		}
+		after = pageInfo.EndCursor
+	}
+	return works, nil
+}
+
+// JST is the time zone which animekai account lives in. Records are aggregated by dates in JST.
+var JST = time.FixedZone("Asia/Tokyo", 9*60*60)
 
-// TODO: Paging.
-func (s *service) listRecords(ctx context.Context) (map[string]struct{ BeginTime, FinishTime time.Time }, error) {
-	res, err := s.client.ListRecords(ctx)
+const (
+	defaultMaxRecordPages     = 100
+	defaultRecordSyncInterval = 1 * time.Minute
+	allWorksPageSize          = 100
+	// maxAllWorksPages limits pages of works fetched per ListAllWorks.
+	maxAllWorksPages = 20
+	recordsPageSize  = 50
+	// lastEpisodeRecheckPeriod is how long records of the last episodes are checked whether their next episodes are
+	// registered.
+	lastEpisodeRecheckPeriod = 30 * 24 * time.Hour
+)
+
+// workPeriod is a period from the first record to the last episode record of a work.
+type workPeriod struct {
+	BeginTime, FinishTime time.Time
+}
+
+// workPeriod returns the period of the work identified by workID according to cached records.
+// It returns nil if the work has no records.
+func (s *service) workPeriod(workID int32) (*workPeriod, error) {
+	records, err := s.records.workRecords(int64(workID))
 	if err != nil {
-		return nil, convertError(err)
+		return nil, failure.Wrap(err)
+	}
+	if len(records) == 0 {
+		return nil, nil
 	}
 
-	records := res.Viewer.Records.Edges
-	sort.Slice(records, func(i, j int) bool {
-		return records[i].Node.Episode.SortNumber < records[j].Node.Episode.SortNumber
-	})
+	p := &workPeriod{BeginTime: records[0].CreatedAt}
+	for _, r := range records {
+		if !r.HasNextEpisode {
+			// Watched all episodes.
+			p.FinishTime = r.CreatedAt
+		}
+	}
+	return p, nil
+}
 
-	m := map[string]struct{ BeginTime, FinishTime time.Time }{}
+// watchedEpisodesCount returns the number of episodes of the work identified by workID which are recorded in cached
+// records.
+func (s *service) watchedEpisodesCount(workID int32) (int, error) {
+	records, err := s.records.workRecords(int64(workID))
+	if err != nil {
+		return 0, failure.Wrap(err)
+	}
+	episodes := make(map[int64]struct{}, len(records))
 	for _, r := range records {
-		w := r.Node.Work
-		_, ok := m[w.Title]
-		if !ok {
-			createdAt, err := time.Parse(time.RFC3339, r.Node.CreatedAt)
-			if err != nil {
-				return nil, convertError(err)
+		episodes[r.EpisodeSortNumber] = struct{}{}
+	}
+	return len(episodes), nil
+}
+
+func (s *service) ListRecords(ctx context.Context, since, until time.Time) ([]*resource.Record, error) {
+	if err := s.syncRecords(ctx); err != nil {
+		return nil, failure.Wrap(err)
+	}
+
+	byWork, err := s.records.list()
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+
+	type entry struct {
+		*record
+		first bool
+	}
+	var entries []entry
+	for _, workRecords := range byWork {
+		for i, r := range workRecords {
+			if r.CreatedAt.Before(since) || !r.CreatedAt.Before(until) {
+				continue
 			}
-			m[w.Title] = struct{ BeginTime, FinishTime time.Time }{
-				BeginTime: createdAt.In(jst),
+			entries = append(entries, entry{record: r, first: i == 0})
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].CreatedAt.Equal(entries[j].CreatedAt) {
+			return entries[i].ID < entries[j].ID
+		}
+		return entries[i].CreatedAt.Before(entries[j].CreatedAt)
+	})
+
+	records := make([]*resource.Record, 0, len(entries))
+	for _, e := range entries {
+		createTime, err := ptypes.TimestampProto(e.CreatedAt)
+		if err != nil {
+			return nil, failure.Translate(err, errors.Internal, failure.Context{"record_id": e.ID})
+		}
+		records = append(records, &resource.Record{
+			Id:                e.ID,
+			WorkId:            int32(e.WorkID),
+			WorkTitle:         e.WorkTitle,
+			EpisodeSortNumber: int32(e.EpisodeSortNumber),
+			FirstRecord:       e.first,
+			LastEpisode:       !e.HasNextEpisode,
+			CreateTime:        createTime,
+		})
+	}
+	return records, nil
+}
+
+// syncRecords fetches records newer than cached ones and adds them to the cache.
+// It does nothing if records are synced within s.recordSyncInterval, so concurrent callers waiting for a sync don't
+// fetch records again.
+func (s *service) syncRecords(ctx context.Context) error {
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+
+	if !s.syncedAt.IsZero() && time.Since(s.syncedAt) < s.recordSyncInterval {
+		return nil
+	}
+
+	since, err := s.records.latestCreatedAt()
+	if err != nil {
+		return failure.Wrap(err)
+	}
+
+	records, err := s.fetchRecordsSince(ctx, since)
+	if err != nil {
+		return failure.Wrap(err)
+	}
+
+	if err := s.records.add(records); err != nil {
+		return failure.Wrap(err)
+	}
+
+	if err := s.refreshLastEpisodes(ctx); err != nil {
+		return failure.Wrap(err)
+	}
+	s.syncedAt = time.Now()
+	return nil
+}
+
+// invalidateRecords makes the next syncRecords fetch records regardless of s.recordSyncInterval.
+// It must be called after creating records.
+func (s *service) invalidateRecords() {
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+	s.syncedAt = time.Time{}
+}
+
+// refreshLastEpisodes re-resolves whether recent records of the latest episodes are the last episodes.
+// Episodes of airing works may be registered after their previous episodes are recorded, so such records are
+// checked again against the live episodes until lastEpisodeRecheckPeriod passes.
+func (s *service) refreshLastEpisodes(ctx context.Context) error {
+	records, err := s.records.lastEpisodeRecords(time.Now().Add(-lastEpisodeRecheckPeriod))
+	if err != nil {
+		return failure.Wrap(err)
+	}
+	if len(records) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(records))
+	sortNumbers := make(map[int64]int64, len(records))
+	for _, r := range records {
+		ids = append(ids, r.WorkID)
+		sortNumbers[r.WorkID] = r.EpisodeSortNumber
+	}
+
+	res, err := s.client.GetWorkEpisodes(ctx, ids)
+	if err != nil {
+		return convertError(err)
+	}
+	if res.SearchWorks == nil {
+		return nil
+	}
+
+	var workIDs []int64
+	for _, e := range res.SearchWorks.Edges {
+		w := e.Node
+		if w.Episodes == nil {
+			continue
+		}
+		for _, ep := range w.Episodes.Nodes {
+			if ep.SortNumber > sortNumbers[w.AnnictID] {
+				workIDs = append(workIDs, w.AnnictID)
+				break
 			}
-		} else if r.Node.Episode.NextEpisode == nil {
+		}
+	}
+	if err := s.records.markHasNextEpisode(workIDs); err != nil {
+		return failure.Wrap(err)
+	}
+	return nil
+}
+
+// fetchRecordsSince follows the records connection from the newest record until a record older than since is found,
+// all pages are fetched or the number of fetched pages reaches s.maxRecordPages.
+// If since is the zero time, fetchRecordsSince fetches all records.
+func (s *service) fetchRecordsSince(ctx context.Context, since time.Time) ([]*record, error) {
+	var (
+		records []*record
+		after   *string
+	)
+	for page := 0; ; page++ {
+		if page == s.maxRecordPages {
+			ctxzap.Extract(ctx).Warn("reached the max number of record pages", zap.Int("max_record_pages", s.maxRecordPages))
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, convertError(err)
+		}
+
+		res, err := s.client.ListRecords(ctx, after, recordsPageSize)
+		if err != nil {
+			return nil, convertError(err)
+		}
+
+		for _, r := range res.Viewer.Records.Edges {
 			createdAt, err := time.Parse(time.RFC3339, r.Node.CreatedAt)
 			if err != nil {
 				return nil, convertError(err)
 			}
-			// Watched all episodes.
-			m[w.Title] = struct{ BeginTime, FinishTime time.Time }{
-				BeginTime:  m[w.Title].BeginTime,
-				FinishTime: createdAt.In(jst),
+			if createdAt.Before(since) {
+				// Remaining records are already cached.
+				return records, nil
 			}
+			records = append(records, &record{
+				ID:                r.Node.ID,
+				WorkID:            r.Node.Work.AnnictID,
+				WorkTitle:         r.Node.Work.Title,
+				EpisodeSortNumber: r.Node.Episode.SortNumber,
+				HasNextEpisode:    r.Node.Episode.NextEpisode != nil,
+				CreatedAt:         createdAt.In(JST),
+			})
 		}
+
+		pageInfo := res.Viewer.Records.PageInfo
+		if !pageInfo.HasNextPage || pageInfo.EndCursor == nil {
+			break
+		}
+		after = pageInfo.EndCursor
 	}
+	return records, nil
+}
 
-	return m, nil
+// plannedEpisode is an episode which is going to be recorded.
+type plannedEpisode struct {
+	id         string
+	title      string
+	sortNumber int64
+	numberText string
+	workID     int32
+	workTitle  string
 }
 
-func (s *service) CreateNextEpisodeRecords(ctx context.Context) ([]*resource.Episode, error) { //nolint:funlen
-	res, err := s.client.ListNextEpisodes(ctx)
-	if err != nil {
-		return nil, convertError(err)
+func newPlannedEpisode(id string, sortNumber int64, numberText, title *string, workID int64, workTitle string) *plannedEpisode {
+	p := &plannedEpisode{
+		id:         id,
+		sortNumber: sortNumber,
+		workID:     int32(workID),
+		workTitle:  workTitle,
 	}
+	if title != nil {
+		p.title = *title
+	}
+	if numberText != nil {
+		p.numberText = *numberText
+	}
+	return p
+}
 
-	finished := map[string]struct{}{}
-	m := map[string]struct {
-		id         string
-		title      string
-		number     int64
-		numberText string
-		workTitle  string
-	}{}
+func (e *plannedEpisode) resource() *resource.Episode {
+	return &resource.Episode{
+		WorkID:     e.workID,
+		WorkTitle:  e.workTitle,
+		Title:      e.title,
+		NumberText: e.numberText,
+	}
+}
 
-	for _, r := range res.Viewer.Records.Edges {
-		e := r.Node.Episode
-		if *e.Work.ViewerStatusState == StatusStateWatched {
-			continue
+// recordPlan is a set of mutations performed by CreateNextEpisodeRecords.
+type recordPlan struct {
+	// episodes are the episodes of watching works to be recorded in order keyed by work IDs.
+	episodes map[string][]*plannedEpisode
+	// finishedWorkIDs are IDs of works whose all episodes are recorded after performing the plan.
+	finishedWorkIDs map[string]struct{}
+}
+
+func (p *recordPlan) resourceEpisodes() []*resource.Episode {
+	episodes := make([]*resource.Episode, 0, len(p.episodes))
+	for _, es := range p.episodes {
+		for _, e := range es {
+			episodes = append(episodes, e.resource())
 		}
-		if e.NextEpisode == nil {
-			finished[e.Work.ID] = struct{}{}
-			continue
+	}
+	sortEpisodes(episodes)
+	return episodes
+}
+
+// sortEpisodes sorts episodes by work titles keeping the order of episodes of each work.
+func sortEpisodes(episodes []*resource.Episode) {
+	sort.SliceStable(episodes, func(i, j int) bool {
+		return episodes[i].WorkTitle < episodes[j].WorkTitle
+	})
+}
+
+func (s *service) CreateNextEpisodeRecords(ctx context.Context, opts ...RecordOption) ([]*resource.Episode, error) {
+	o, err := newRecordOptions(opts)
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+
+	if o.dryRun {
+		return s.planResourceEpisodes(ctx, o)
+	}
+
+	// Serialize recordings so that concurrent calls in the same session don't create records twice.
+	s.recordMu.Lock()
+	defer s.recordMu.Unlock()
+
+	plan, err := s.planNextEpisodeRecords(ctx, &o.filter, o.episodes)
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+
+	session, alreadyRecorded, err := s.resumeRecordSession(ctx, plan, o.sessionID)
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+
+	episodes, err := s.performRecordPlan(ctx, plan, session, o)
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+
+	episodes = append(alreadyRecorded, episodes...)
+	sortEpisodes(episodes)
+	return episodes, nil
+}
+
+// planResourceEpisodes returns episodes which would be recorded with o.
+func (s *service) planResourceEpisodes(ctx context.Context, o *recordOptions) ([]*resource.Episode, error) {
+	plan, err := s.planNextEpisodeRecords(ctx, &o.filter, o.episodes)
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+	return plan.resourceEpisodes(), nil
+}
+
+func newRecordOptions(opts []RecordOption) (*recordOptions, error) {
+	o := &recordOptions{episodes: 1}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.episodes < 1 {
+		return nil, failure.New(errors.InvalidArgument, failure.Context{"episodes": strconv.Itoa(o.episodes)})
+	}
+	for _, r := range o.ratings {
+		if !r.state.IsValid() {
+			return nil, failure.New(errors.InvalidArgument, failure.Context{"rating": r.state.String()})
 		}
+	}
+	return o, nil
+}
 
-		if m[e.Work.ID].number < e.NextEpisode.SortNumber {
-			s := struct {
-				id         string
-				title      string
-				number     int64
-				numberText string
-				workTitle  string
-			}{
-				e.NextEpisode.ID,
-				*e.NextEpisode.Title,
-				e.NextEpisode.SortNumber,
-				*e.NextEpisode.NumberText,
-				e.Work.Title,
-			}
-			if e.NextEpisode.Title != nil {
-				s.title = *e.NextEpisode.Title
-			}
-			m[e.Work.ID] = s
+// resumeRecordSession returns the latest session if the call identified by sessionID belongs to it, or a new session
+// otherwise. Works which are already recorded in the session are removed from plan, and their episodes are returned.
+func (s *service) resumeRecordSession(
+	ctx context.Context,
+	plan *recordPlan,
+	sessionID string,
+) (_ *recordSession, alreadyRecorded []*resource.Episode, _ error) {
+	now := time.Now()
+	session, err := s.sessions.latest()
+	if err != nil {
+		return nil, nil, failure.Wrap(err)
+	}
+	if session == nil || !session.includes(sessionID, now, s.recordSessionWindow) {
+		session = newRecordSession(sessionID, now)
+	}
+
+	for id, es := range plan.episodes {
+		if r := session.recorded(es[0].workID); len(r) != 0 {
+			alreadyRecorded = append(alreadyRecorded, r...)
+			delete(plan.episodes, id)
+			delete(plan.finishedWorkIDs, id)
 		}
 	}
+	if len(alreadyRecorded) != 0 {
+		ctxzap.Extract(ctx).Info("some works are already recorded in the session", zap.String("session_id", session.ID))
+	}
+	return session, alreadyRecorded, nil
+}
+
+// sessionRecorder collects mutations performed concurrently into a session.
+type sessionRecorder struct {
+	mu       sync.Mutex
+	session  *recordSession
+	episodes []*resource.Episode
+}
+
+func (r *sessionRecorder) recorded(e *plannedEpisode, recordID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.episodes = append(r.episodes, e.resource())
+	if recordID != "" {
+		r.session.RecordIDs = append(r.session.RecordIDs, recordID)
+	}
+}
 
+func (r *sessionRecorder) finished(workID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.session.FinishedWorkIDs = append(r.session.FinishedWorkIDs, workID)
+}
+
+// performRecordPlan performs mutations of plan and journals them in session. It returns the recorded episodes.
+// Created records and finished works are journaled even if some mutations fail so that they can be undone by
+// UndoRecordSession. Sessions without any mutations are not journaled so that they don't replace the latest session
+// to be undone.
+func (s *service) performRecordPlan(
+	ctx context.Context,
+	plan *recordPlan,
+	session *recordSession,
+	o *recordOptions,
+) ([]*resource.Episode, error) {
+	r := &sessionRecorder{session: session}
 	var eg errgroup.Group
-	for _, e := range m {
-		e := e
+	for workID, es := range plan.episodes {
+		workID, es := workID, es
+		_, finished := plan.finishedWorkIDs[workID]
+		delete(plan.finishedWorkIDs, workID)
 		eg.Go(func() error {
-			_, err := s.client.CreateRecordMutation(ctx, e.id)
-			if err != nil {
-				return failure.Wrap(convertError(err), failure.Context{"episode_id": e.id})
+			return s.recordWork(ctx, workID, es, finished, o, r)
+		})
+	}
+	for workID := range plan.finishedWorkIDs {
+		workID := workID
+		eg.Go(func() error {
+			return s.recordWork(ctx, workID, nil, true, o, r)
+		})
+	}
+
+	recordErr := eg.Wait()
+	s.invalidateRecords()
+	sortEpisodes(r.episodes)
+	session.Episodes = append(session.Episodes, r.episodes...)
+	if !session.empty() {
+		if err := s.sessions.save(session); err != nil {
+			return nil, failure.Wrap(err)
+		}
+	}
+	if recordErr != nil {
+		return nil, failure.Wrap(recordErr)
+	}
+	return r.episodes, nil
+}
+
+// recordWork records episodes of the work in order, and marks the work as WATCHED if finished is true.
+func (s *service) recordWork(
+	ctx context.Context,
+	workID string,
+	episodes []*plannedEpisode,
+	finished bool,
+	o *recordOptions,
+	r *sessionRecorder,
+) error {
+	for i, e := range episodes {
+		// The comment and the rating are attached to the last episode of the work only.
+		var (
+			comment *string
+			rating  *RatingState
+		)
+		if i == len(episodes)-1 {
+			comment, rating = o.reaction(int64(e.workID), e.workTitle)
+		}
+		res, err := s.client.CreateRecordMutation(ctx, e.id, comment, rating)
+		if err != nil {
+			return failure.Wrap(convertError(err), failure.Context{"episode_id": e.id})
+		}
+		var recordID string
+		if res.CreateRecord != nil && res.CreateRecord.Record != nil {
+			recordID = res.CreateRecord.Record.ID
+		}
+		r.recorded(e, recordID)
+	}
+	if !finished {
+		return nil
+	}
+	if err := s.finishWork(ctx, workID); err != nil {
+		return err
+	}
+	r.finished(workID)
+	return nil
+}
+
+func (s *service) UndoRecordSession(ctx context.Context) ([]*resource.Episode, error) {
+	s.recordMu.Lock()
+	defer s.recordMu.Unlock()
+
+	session, err := s.sessions.latest()
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+	if session == nil || (len(session.RecordIDs) == 0 && len(session.FinishedWorkIDs) == 0) {
+		return nil, failure.New(errors.NotFound, failure.Message("no record sessions to undo"))
+	}
+
+	var (
+		mu                      sync.Mutex
+		deletedIDs, revertedIDs []string
+		eg                      errgroup.Group
+	)
+	for _, id := range session.RecordIDs {
+		id := id
+		eg.Go(func() error {
+			if _, err := s.client.DeleteRecordMutation(ctx, id); err != nil {
+				return failure.Wrap(convertError(err), failure.Context{"record_id": id})
 			}
+			mu.Lock()
+			deletedIDs = append(deletedIDs, id)
+			mu.Unlock()
 			return nil
 		})
 	}
-	for workID := range finished {
-		workID := workID
+	for _, id := range session.FinishedWorkIDs {
+		id := id
 		eg.Go(func() error {
-			_, err := s.client.UpdateStatusMutation(ctx, StatusStateWatched, workID)
-			if err != nil {
-				return failure.Wrap(convertError(err), failure.Context{"work_id": workID})
+			if _, err := s.client.UpdateStatusMutation(ctx, StatusStateWatching, id); err != nil {
+				return failure.Wrap(convertError(err), failure.Context{"work_id": id})
 			}
+			mu.Lock()
+			revertedIDs = append(revertedIDs, id)
+			mu.Unlock()
 			return nil
 		})
 	}
+	undoErr := eg.Wait()
 
-	if err := eg.Wait(); err != nil {
+	if err := s.records.remove(deletedIDs); err != nil {
 		return nil, failure.Wrap(err)
 	}
 
-	episodes := make([]*resource.Episode, 0, len(m))
-	for _, r := range m {
-		episodes = append(episodes, &resource.Episode{
-			WorkTitle:  r.workTitle,
-			Title:      r.title,
-			NumberText: r.numberText,
-		})
+	if undoErr != nil {
+		// Keep the rest of the session so that undo can be retried.
+		session.RecordIDs = subtract(session.RecordIDs, deletedIDs)
+		session.FinishedWorkIDs = subtract(session.FinishedWorkIDs, revertedIDs)
+		if err := s.sessions.save(session); err != nil {
+			return nil, failure.Wrap(err)
+		}
+		return nil, failure.Wrap(undoErr)
 	}
 
-	return episodes, nil
+	if err := s.sessions.clear(); err != nil {
+		return nil, failure.Wrap(err)
+	}
+	return session.Episodes, nil
+}
+
+// subtract returns elements of a which are not contained in b.
+func subtract(a, b []string) []string {
+	m := make(map[string]struct{}, len(b))
+	for _, v := range b {
+		m[v] = struct{}{}
+	}
+	var res []string
+	for _, v := range a {
+		if _, ok := m[v]; !ok {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// finishWork marks the work identified by workID as WATCHED.
+func (s *service) finishWork(ctx context.Context, workID string) error {
+	if _, err := s.client.UpdateStatusMutation(ctx, StatusStateWatched, workID); err != nil {
+		return failure.Wrap(convertError(err), failure.Context{"work_id": workID})
+	}
+	return nil
+}
+
+// planNextEpisodeRecords lists at most n episodes following the last record of each watching work and works which
+// should be marked as WATCHED. Works which are not matched with filter are ignored.
+func (s *service) planNextEpisodeRecords(ctx context.Context, filter *workFilter, n int) (*recordPlan, error) {
+	res, err := s.client.ListNextEpisodes(ctx)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	plan := &recordPlan{
+		episodes:        map[string][]*plannedEpisode{},
+		finishedWorkIDs: map[string]struct{}{},
+	}
+	for _, r := range res.Viewer.Records.Edges {
+		e := r.Node.Episode
+		// Works which are dropped or held after the last record are not recorded.
+		if e.Work.ViewerStatusState == nil || *e.Work.ViewerStatusState != StatusStateWatching {
+			continue
+		}
+		if !filter.match(e.Work.AnnictID, e.Work.Title) {
+			continue
+		}
+		if e.NextEpisode == nil {
+			plan.finishedWorkIDs[e.Work.ID] = struct{}{}
+			continue
+		}
+
+		if p, ok := plan.episodes[e.Work.ID]; !ok || p[0].sortNumber < e.NextEpisode.SortNumber {
+			next := e.NextEpisode
+			plan.episodes[e.Work.ID] = []*plannedEpisode{
+				newPlannedEpisode(next.ID, next.SortNumber, next.NumberText, next.Title, e.Work.AnnictID, e.Work.Title),
+			}
+		}
+	}
+
+	if n > 1 {
+		if err := s.extendRecordPlan(ctx, plan, n); err != nil {
+			return nil, err
+		}
+	}
+	return plan, nil
+}
+
+// extendRecordPlan extends the planned episodes of each work to at most n episodes from the next episode.
+// Works whose episodes run out within the n episodes are marked as WATCHED after recording them.
+func (s *service) extendRecordPlan(ctx context.Context, plan *recordPlan, n int) error {
+	if len(plan.episodes) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(plan.episodes))
+	workIDs := make(map[int64]string, len(plan.episodes))
+	for id, es := range plan.episodes {
+		annictID := int64(es[0].workID)
+		ids = append(ids, annictID)
+		workIDs[annictID] = id
+	}
+
+	res, err := s.client.GetWorkEpisodes(ctx, ids)
+	if err != nil {
+		return convertError(err)
+	}
+	if res.SearchWorks == nil {
+		return nil
+	}
+
+	for _, edge := range res.SearchWorks.Edges {
+		w := edge.Node
+		id, ok := workIDs[w.AnnictID]
+		if !ok || w.Episodes == nil {
+			continue
+		}
+
+		next := plan.episodes[id][0]
+		var (
+			episodes []*plannedEpisode
+			last     bool
+		)
+		for _, e := range w.Episodes.Nodes {
+			if e.SortNumber < next.sortNumber {
+				continue
+			}
+			if len(episodes) == n {
+				break
+			}
+			episodes = append(episodes, newPlannedEpisode(e.ID, e.SortNumber, e.NumberText, e.Title, w.AnnictID, next.workTitle))
+			// Episodes may not be fetched to the end, so the work is finished only if the episode has no next one.
+			last = e.NextEpisode == nil
+		}
+		if len(episodes) == 0 {
+			continue
+		}
+		plan.episodes[id] = episodes
+		if last {
+			plan.finishedWorkIDs[id] = struct{}{}
+		}
+	}
+	return nil
+}
+
+func (s *service) GetWorkImageURL(ctx context.Context, workID int32) (string, error) {
+	res, err := s.client.GetWorkImage(ctx, []int64{int64(workID)})
+	if err != nil {
+		return "", convertError(err)
+	}
+	if len(res.SearchWorks.Edges) == 0 {
+		return "", failure.New(errors.NotFound, failure.Context{"work_id": fmt.Sprint(workID)})
+	}
+
+	img := &workImage{workID: workID}
+	if i := res.SearchWorks.Edges[0].Node.Image; i != nil {
+		img.urls = imageURLs(i.RecommendedImageURL, i.FacebookOgImageURL, i.TwitterBiggerAvatarURL, i.TwitterAvatarURL)
+	}
+	url, err := s.imageResolver.resolve(ctx, img)
+	if err != nil {
+		return "", failure.Wrap(err)
+	}
+	return url, nil
+}
+
+// StatusOption configures UpdateWorkStatus.
+type StatusOption func(*statusOptions)
+
+type statusOptions struct {
+	recordFirstEpisode bool
 }
 
-func (s *service) UpdateWorkStatus(ctx context.Context, workID int, state StatusState) error {
+// RecordFirstEpisode makes UpdateWorkStatus record the first episode of the work as well.
+func RecordFirstEpisode() StatusOption {
+	return func(o *statusOptions) {
+		o.recordFirstEpisode = true
+	}
+}
+
+func (s *service) UpdateWorkStatus(ctx context.Context, workID int, state StatusState, opts ...StatusOption) error {
+	var o statusOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if !state.IsValid() || state == StatusStateNoState {
+		return failure.New(errors.InvalidArgument, failure.Context{"state": state.String()})
+	}
+
 	res, err := s.client.GetWork(ctx, []int64{int64(workID)})
 	if err != nil {
 		return convertError(err)
 	}
+	if res.SearchWorks == nil || len(res.SearchWorks.Edges) == 0 {
+		return failure.New(errors.NotFound, failure.Context{"work_id": strconv.Itoa(workID)})
+	}
+	work := res.SearchWorks.Edges[0].Node
 
 	var eg errgroup.Group
 	eg.Go(func() error {
-		_, err := s.client.UpdateWorkStatus(ctx, res.SearchWorks.Edges[0].Node.ID)
-		return convertError(err)
-	})
-	eg.Go(func() error {
-		_, err := s.client.CreateRecordMutation(ctx, res.SearchWorks.Edges[0].Node.Episodes.Nodes[0].ID)
+		_, err := s.client.UpdateStatusMutation(ctx, state, work.ID)
 		return convertError(err)
 	})
+	if o.recordFirstEpisode && work.Episodes != nil && len(work.Episodes.Nodes) != 0 {
+		eg.Go(func() error {
+			_, err := s.client.CreateRecordMutation(ctx, work.Episodes.Nodes[0].ID, nil, nil)
+			s.invalidateRecords()
+			return convertError(err)
+		})
+	}
 	if err := eg.Wait(); err != nil {
 		return failure.Wrap(err)
 	}
@@ -2,6 +2,7 @@ package annict
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
@@ -12,10 +13,13 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/GoodCodingFriends/animekai/errors"
 	"github.com/GoodCodingFriends/animekai/resource"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/morikuni/failure"
 )
 
 func init() {
@@ -57,6 +61,617 @@ func TestGetProfile(t *testing.T) {
 	}
 }
 
+func TestFetchRecordsSince(t *testing.T) {
+	pages := map[string]string{
+		"": `{"data": {"viewer": {"records": {
+			"pageInfo": {"hasNextPage": true, "endCursor": "Mg"},
+			"edges": [
+				{"node": {"id": "3", "work": {"annictId": 1, "title": "a"}, "episode": {"sortNumber": 30}, "createdAt": "2020-05-20T16:49:01Z"}},
+				{"node": {"id": "2", "work": {"annictId": 1, "title": "a"}, "episode": {"sortNumber": 20, "nextEpisode": {"id": "3"}}, "createdAt": "2020-05-19T16:49:50Z"}}
+			]
+		}}}}`,
+		"Mg": `{"data": {"viewer": {"records": {
+			"pageInfo": {"hasNextPage": false, "endCursor": "Mw"},
+			"edges": [
+				{"node": {"id": "1", "work": {"annictId": 1, "title": "a"}, "episode": {"sortNumber": 10, "nextEpisode": {"id": "2"}}, "createdAt": "2020-05-19T16:49:01Z"}}
+			]
+		}}}}`,
+	}
+
+	cases := map[string]struct {
+		maxRecordPages int
+		since          time.Time
+		want           int
+	}{
+		"all pages are fetched":          {maxRecordPages: defaultMaxRecordPages, want: 3},
+		"pages are limited by the bound": {maxRecordPages: 1, want: 2},
+		"invalid bound is ignored":       {maxRecordPages: 0, want: 3},
+		"older records are not fetched": {
+			maxRecordPages: defaultMaxRecordPages,
+			since:          time.Date(2020, 5, 19, 16, 49, 50, 0, time.UTC),
+			want:           2,
+		},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var req struct {
+					Variables struct {
+						After string `json:"after"`
+					} `json:"variables"`
+				}
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					t.Errorf("failed to decode request: '%s'", err)
+					return
+				}
+				if _, err := io.WriteString(w, pages[req.Variables.After]); err != nil {
+					t.Errorf("WriteString should not return an error, but got '%s'", err)
+				}
+			}))
+			t.Cleanup(srv.Close)
+
+			s := New("", srv.URL, WithMaxRecordPages(c.maxRecordPages)).(*service)
+			records, err := s.fetchRecordsSince(context.Background(), c.since)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(records) != c.want {
+				t.Errorf("expected number of records is %d, but got %d", c.want, len(records))
+			}
+		})
+	}
+}
+
+func TestListRecordsRefreshesLastEpisodes(t *testing.T) {
+	now := time.Now()
+	node := func(id string, workID, sortNumber int, d time.Duration) string {
+		return fmt.Sprintf(
+			`{"node": {"id": %q, "work": {"annictId": %d, "title": "w%d"}, "episode": {"sortNumber": %d}, "createdAt": %q}}`,
+			id, workID, workID, sortNumber, now.Add(-d).UTC().Format(time.RFC3339),
+		)
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("failed to read request: '%s'", err)
+			return
+		}
+		var res string
+		switch {
+		case strings.Contains(string(b), "listRecords"):
+			// All records are fetched before their next episodes are registered.
+			res = fmt.Sprintf(`{"data": {"viewer": {"records": {"pageInfo": {"hasNextPage": false}, "edges": [%s]}}}}`, strings.Join([]string{
+				node("3", 2, 10, 1*time.Hour),
+				node("2", 1, 20, 2*time.Hour),
+				node("1", 1, 10, 3*time.Hour),
+			}, ","))
+		case strings.Contains(string(b), "GetWorkEpisodes"):
+			res = `{"data": {"searchWorks": {"edges": [
+				{"node": {"annictId": 1, "episodes": {"nodes": [{"sortNumber": 10}, {"sortNumber": 20}, {"sortNumber": 30}]}}},
+				{"node": {"annictId": 2, "episodes": {"nodes": [{"sortNumber": 10}]}}}
+			]}}}`
+		default:
+			t.Errorf("unexpected request: %s", string(b))
+		}
+		if _, err := io.WriteString(w, res); err != nil {
+			t.Errorf("WriteString should not return an error, but got '%s'", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	records, err := New("", srv.URL).ListRecords(context.Background(), time.Time{}, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]bool{}
+	for _, r := range records {
+		got[r.Id] = r.LastEpisode
+	}
+	want := map[string]bool{"1": false, "2": false, "3": true}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("-want, +got\n%s", diff)
+	}
+}
+
+func TestSyncRecordsInterval(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if _, err := io.WriteString(w, `{"data": {"viewer": {"records": {"pageInfo": {"hasNextPage": false}, "edges": []}}}}`); err != nil {
+			t.Errorf("WriteString should not return an error, but got '%s'", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	s := New("", srv.URL).(*service)
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		if err := s.syncRecords(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("records should be fetched once within the sync interval, but fetched %d times", requests)
+	}
+
+	s.invalidateRecords()
+	if err := s.syncRecords(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if requests != 2 {
+		t.Errorf("records should be fetched after invalidation, but fetched %d times", requests)
+	}
+}
+
+func TestCreateNextEpisodeRecords(t *testing.T) {
+	var mutations int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("failed to read request: '%s'", err)
+			return
+		}
+		res := `{"data": {}}`
+		switch {
+		case strings.Contains(string(b), "ListNextEpisodes"):
+			res = `{"data": {"viewer": {"records": {"edges": [
+				{"node": {"episode": {
+					"nextEpisode": {"id": "RXBpc29kZS0xNDIyOA==", "sortNumber": 20, "numberText": "第二話", "title": "ろうたけたる思い"},
+					"work": {"id": "V29yay00MTYy", "title": "結城友奈は勇者である", "viewerStatusState": "WATCHING"}
+				}}},
+				{"node": {"episode": {
+					"nextEpisode": {"id": "RXBpc29kZS0zMDAw", "sortNumber": 30, "numberText": "第三話"},
+					"work": {"id": "V29yay01NzQ1", "title": "のんのんびより りぴーと", "viewerStatusState": "STOP_WATCHING"}
+				}}}
+			]}}}}`
+		case strings.Contains(string(b), "CreateRecordMutation"):
+			mutations++
+		}
+		if _, err := io.WriteString(w, res); err != nil {
+			t.Errorf("WriteString should not return an error, but got '%s'", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	s := New("", srv.URL)
+	ctx := context.Background()
+
+	episodes, err := s.CreateNextEpisodeRecords(ctx, DryRun())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(episodes) != 1 || mutations != 0 {
+		t.Errorf("dry-run should return 1 planned episode without mutations, but got %d episodes and %d mutations", len(episodes), mutations)
+	}
+
+	for i := 0; i < 2; i++ {
+		episodes, err := s.CreateNextEpisodeRecords(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(episodes) != 1 {
+			t.Errorf("expected number of episodes is 1, but got %d", len(episodes))
+		}
+	}
+	if mutations != 1 {
+		t.Errorf("records should be created only once in the same session, but created %d times", mutations)
+	}
+
+	if _, err := s.CreateNextEpisodeRecords(ctx, WithSessionID("another")); err != nil {
+		t.Fatal(err)
+	}
+	if mutations != 2 {
+		t.Errorf("records should be created in another session, but created %d times", mutations)
+	}
+}
+
+func TestCreateNextEpisodeRecordsWithOptions(t *testing.T) {
+	const allEpisodes = `
+		{"id": "e1", "sortNumber": 10, "nextEpisode": {"id": "e2"}},
+		{"id": "e2", "sortNumber": 20, "nextEpisode": {"id": "e3"}},
+		{"id": "e3", "sortNumber": 30, "nextEpisode": {"id": "e4"}},
+		{"id": "e4", "sortNumber": 40}`
+	var (
+		mutations    []string
+		episodeNodes string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Query     string
+			Variables map[string]interface{}
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: '%s'", err)
+			return
+		}
+		res := `{"data": {}}`
+		switch {
+		case strings.Contains(req.Query, "ListNextEpisodes"):
+			res = `{"data": {"viewer": {"records": {"edges": [
+				{"node": {"episode": {
+					"nextEpisode": {"id": "e2", "sortNumber": 20, "nextEpisode": {"id": "e3"}},
+					"work": {"id": "V29yay00MTYy", "annictId": 4162, "title": "結城友奈は勇者である", "viewerStatusState": "WATCHING"}
+				}}}
+			]}}}}`
+		case strings.Contains(req.Query, "GetWorkEpisodes"):
+			res = fmt.Sprintf(`{"data": {"searchWorks": {"edges": [
+				{"node": {"annictId": 4162, "episodes": {"nodes": [%s]}}}
+			]}}}`, episodeNodes)
+		case strings.Contains(req.Query, "CreateRecordMutation"):
+			m := req.Variables["episodeId"].(string)
+			for _, k := range []string{"ratingState", "comment"} {
+				if v, ok := req.Variables[k].(string); ok {
+					m += " " + v
+				}
+			}
+			mutations = append(mutations, m)
+		case strings.Contains(req.Query, "UpdateStatusMutation"):
+			mutations = append(mutations, req.Variables["state"].(string))
+		}
+		if _, err := io.WriteString(w, res); err != nil {
+			t.Errorf("WriteString should not return an error, but got '%s'", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cases := map[string]struct {
+		opts []RecordOption
+		// episodeNodes is episodes returned by GetWorkEpisodes. All episodes are returned if it is empty.
+		episodeNodes string
+		episodes     int
+		mutations    []string
+	}{
+		"in the middle": {
+			opts:      []RecordOption{WithEpisodes(2)},
+			episodes:  2,
+			mutations: []string{"e2", "e3"},
+		},
+		"run out": {
+			opts:      []RecordOption{WithEpisodes(5)},
+			episodes:  3,
+			mutations: []string{"e2", "e3", "e4", "WATCHED"},
+		},
+		"not fetched to the end": {
+			opts: []RecordOption{WithEpisodes(5)},
+			episodeNodes: `
+				{"id": "e1", "sortNumber": 10, "nextEpisode": {"id": "e2"}},
+				{"id": "e2", "sortNumber": 20, "nextEpisode": {"id": "e3"}},
+				{"id": "e3", "sortNumber": 30, "nextEpisode": {"id": "e4"}}`,
+			episodes:  2,
+			mutations: []string{"e2", "e3"},
+		},
+		"with reaction": {
+			opts:      []RecordOption{WithEpisodes(2), WithRating("4162", RatingStateGreat), WithComment("最高")},
+			episodes:  2,
+			mutations: []string{"e2", "e3 GREAT 最高"},
+		},
+		"not rated": {
+			opts:      []RecordOption{WithRating("のんのんびより", RatingStateGood)},
+			episodes:  1,
+			mutations: []string{"e2"},
+		},
+	}
+
+	for name, c := range cases {
+		c := c
+		t.Run(name, func(t *testing.T) {
+			mutations = nil
+			episodeNodes = c.episodeNodes
+			if episodeNodes == "" {
+				episodeNodes = allEpisodes
+			}
+			s := New("", srv.URL)
+
+			episodes, err := s.CreateNextEpisodeRecords(context.Background(), c.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(c.mutations, mutations); diff != "" {
+				t.Errorf("-want, +got\n%s", diff)
+			}
+			if len(episodes) != c.episodes {
+				t.Errorf("expected number of episodes is %d, but got %d", c.episodes, len(episodes))
+			}
+		})
+	}
+}
+
+func TestUndoRecordSession(t *testing.T) {
+	var mutations []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Query     string
+			Variables map[string]interface{}
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: '%s'", err)
+			return
+		}
+		res := `{"data": {}}`
+		switch {
+		case strings.Contains(req.Query, "ListNextEpisodes"):
+			res = `{"data": {"viewer": {"records": {"edges": [
+				{"node": {"episode": {
+					"nextEpisode": {"id": "e2", "sortNumber": 20},
+					"work": {"id": "w1", "annictId": 4162, "title": "結城友奈は勇者である", "viewerStatusState": "WATCHING"}
+				}}},
+				{"node": {"episode": {
+					"work": {"id": "w2", "annictId": 615, "title": "のんのんびより", "viewerStatusState": "WATCHING"}
+				}}}
+			]}}}}`
+		case strings.Contains(req.Query, "CreateRecordMutation"):
+			res = fmt.Sprintf(`{"data": {"createRecord": {"record": {"id": "r-%s"}}}}`, req.Variables["episodeId"])
+		case strings.Contains(req.Query, "DeleteRecordMutation"):
+			mutations = append(mutations, fmt.Sprintf("delete %s", req.Variables["recordId"]))
+		case strings.Contains(req.Query, "UpdateStatusMutation"):
+			mutations = append(mutations, fmt.Sprintf("%s %s", req.Variables["workId"], req.Variables["state"]))
+		}
+		if _, err := io.WriteString(w, res); err != nil {
+			t.Errorf("WriteString should not return an error, but got '%s'", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	s := New("", srv.URL)
+	ctx := context.Background()
+
+	if _, err := s.CreateNextEpisodeRecords(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.(*service).records.add([]*record{{ID: "r-e2", WorkID: 4162, CreatedAt: time.Now()}}); err != nil {
+		t.Fatal(err)
+	}
+	// A call recording nothing doesn't replace the session to be undone.
+	if _, err := s.CreateNextEpisodeRecords(ctx, WithSessionID("another"), IncludeWorks("ゆるキャン△")); err != nil {
+		t.Fatal(err)
+	}
+	mutations = nil
+
+	episodes, err := s.UndoRecordSession(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(episodes) != 1 {
+		t.Errorf("expected number of episodes is 1, but got %d", len(episodes))
+	}
+	want := []string{"delete r-e2", "w2 WATCHING"}
+	if diff := cmp.Diff(want, mutations, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+		t.Errorf("-want, +got\n%s", diff)
+	}
+	records, err := s.(*service).records.workRecords(4162)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 0 {
+		t.Errorf("undone records should be removed from the cache, but got %d records", len(records))
+	}
+
+	if _, err := s.UndoRecordSession(ctx); !failure.Is(err, errors.NotFound) {
+		t.Errorf("UndoRecordSession should return NotFound if there is no session, but got '%v'", err)
+	}
+}
+
+func TestUpdateWorkStatus(t *testing.T) {
+	var mutations []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Query     string
+			Variables map[string]interface{}
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: '%s'", err)
+			return
+		}
+		res := `{"data": {}}`
+		switch {
+		case strings.Contains(req.Query, "GetWork"):
+			res = `{"data": {"searchWorks": {"edges": [
+				{"node": {"id": "w1", "title": "のんのんびより", "episodes": {"nodes": [{"id": "e1"}]}}}
+			]}}}`
+		case strings.Contains(req.Query, "CreateRecordMutation"):
+			mutations = append(mutations, fmt.Sprintf("record %s", req.Variables["episodeId"]))
+		case strings.Contains(req.Query, "UpdateStatusMutation"):
+			mutations = append(mutations, fmt.Sprintf("%s %s", req.Variables["workId"], req.Variables["state"]))
+		}
+		if _, err := io.WriteString(w, res); err != nil {
+			t.Errorf("WriteString should not return an error, but got '%s'", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cases := map[string]struct {
+		state     StatusState
+		opts      []StatusOption
+		mutations []string
+	}{
+		"drop": {
+			state:     StatusStateStopWatching,
+			mutations: []string{"w1 STOP_WATCHING"},
+		},
+		"record first episode": {
+			state:     StatusStateWatching,
+			opts:      []StatusOption{RecordFirstEpisode()},
+			mutations: []string{"record e1", "w1 WATCHING"},
+		},
+	}
+
+	for name, c := range cases {
+		c := c
+		t.Run(name, func(t *testing.T) {
+			mutations = nil
+			s := New("", srv.URL)
+			if err := s.UpdateWorkStatus(context.Background(), 615, c.state, c.opts...); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(c.mutations, mutations, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("-want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSearchWorks(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		res := `{"data": {"searchWorks": {"nodes": [
+			{"annictId": 1, "title": "のんのんびより りぴーと", "titleKana": "のんのんびより りぴーと"},
+			{"annictId": 2, "title": "のんのんびより", "titleKana": "のんのんびより", "titleEn": "Non Non Biyori", "seasonYear": 2013, "seasonName": "AUTUMN"}
+		]}}}`
+		if _, err := io.WriteString(w, res); err != nil {
+			t.Errorf("WriteString should not return an error, but got '%s'", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cases := map[string]struct {
+		title string
+		want  []int32
+	}{
+		"partial match": {title: "のんのん", want: []int32{1, 2}},
+		"exact match":   {title: "のんのん びより", want: []int32{2}},
+		"english title": {title: "non non biyori", want: []int32{2}},
+	}
+
+	for name, c := range cases {
+		c := c
+		t.Run(name, func(t *testing.T) {
+			works, err := New("", srv.URL).SearchWorks(context.Background(), c.title, 10)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ids []int32
+			for _, w := range works {
+				ids = append(ids, w.Id)
+			}
+			if diff := cmp.Diff(c.want, ids); diff != "" {
+				t.Errorf("-want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestListUpcomingPrograms(t *testing.T) {
+	now := time.Now()
+	program := func(id int, d time.Duration, state ProgramState, status StatusState) string {
+		return fmt.Sprintf(
+			`{"node": {"annictId": %d, "startedAt": %q, "state": %q, "channel": {"name": "TOKYO MX"}, "episode": {"numberText": "第%d話"}, "work": {"annictId": 4162, "title": "のんのんびより", "viewerStatusState": %q}}}`,
+			id, now.Add(d).UTC().Format(time.RFC3339), state, id, status,
+		)
+	}
+	edges := []string{
+		program(1, 30*time.Hour, ProgramStatePublished, StatusStateWatching),
+		program(2, 2*time.Hour, ProgramStatePublished, StatusStateWatching),
+		program(3, 90*time.Minute, ProgramStateHidden, StatusStateWatching),
+		program(4, 1*time.Hour, ProgramStatePublished, StatusStateWannaWatch),
+		program(5, 30*time.Minute, ProgramStatePublished, StatusStateWatching),
+		program(6, -1*time.Hour, ProgramStatePublished, StatusStateWatching),
+	}
+
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		res := fmt.Sprintf(
+			`{"data": {"viewer": {"programs": {"pageInfo": {"hasNextPage": true, "endCursor": "Ng"}, "edges": [%s]}}}}`,
+			strings.Join(edges, ","),
+		)
+		if _, err := io.WriteString(w, res); err != nil {
+			t.Errorf("WriteString should not return an error, but got '%s'", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	programs, err := New("", srv.URL).ListUpcomingPrograms(context.Background(), 24*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []int32
+	for _, p := range programs {
+		ids = append(ids, p.Id)
+	}
+	if diff := cmp.Diff([]int32{5, 2}, ids); diff != "" {
+		t.Errorf("-want, +got\n%s", diff)
+	}
+	if requests != 1 {
+		t.Errorf("the next page should not be fetched after a started program, but %d pages are fetched", requests)
+	}
+}
+
+func TestListReviews(t *testing.T) {
+	edge := func(cursor, typename string) string {
+		node := fmt.Sprintf(`{"__typename": %q}`, typename)
+		if typename == reviewTypename {
+			node = fmt.Sprintf(`{"__typename": %q, "id": %q, "body": "", "createdAt": "2020-07-20T13:04:21Z"}`, typename, cursor)
+		}
+		return fmt.Sprintf(`{"cursor": %q, "node": %s}`, cursor, node)
+	}
+	pages := map[string]string{
+		"": fmt.Sprintf(`{"data": {"viewer": {"activities": {"pageInfo": {"hasNextPage": true, "endCursor": "c4"}, "edges": [%s]}}}}`,
+			strings.Join([]string{edge("c1", reviewTypename), edge("c2", "Record"), edge("c3", reviewTypename), edge("c4", reviewTypename)}, ",")),
+		"c3": fmt.Sprintf(`{"data": {"viewer": {"activities": {"pageInfo": {"hasNextPage": false, "endCursor": "c4"}, "edges": [%s]}}}}`,
+			edge("c4", reviewTypename)),
+		"records": fmt.Sprintf(`{"data": {"viewer": {"activities": {"pageInfo": {"hasNextPage": true, "endCursor": "records"}, "edges": [%s]}}}}`,
+			edge("records", "Record")),
+	}
+
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		var req struct {
+			Variables struct {
+				After string `json:"after"`
+				N     int    `json:"n"`
+			} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: '%s'", err)
+			return
+		}
+		if req.Variables.N != reviewActivitiesPageSize {
+			t.Errorf("expected page size is %d, but got %d", reviewActivitiesPageSize, req.Variables.N)
+		}
+		if _, err := io.WriteString(w, pages[req.Variables.After]); err != nil {
+			t.Errorf("WriteString should not return an error, but got '%s'", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cases := map[string]struct {
+		cursor     string
+		ids        []string
+		nextCursor string
+		requests   int
+	}{
+		"first page":                 {ids: []string{"c1", "c3"}, nextCursor: "c3", requests: 1},
+		"last page":                  {cursor: "c3", ids: []string{"c4"}, requests: 1},
+		"pages are limited by bound": {cursor: "records", nextCursor: "records", requests: maxReviewPages},
+	}
+	for name, c := range cases {
+		c := c
+		t.Run(name, func(t *testing.T) {
+			requests = 0
+			reviews, nextCursor, err := New("", srv.URL).ListReviews(context.Background(), c.cursor, 2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ids []string
+			for _, r := range reviews {
+				ids = append(ids, r.Id)
+			}
+			if diff := cmp.Diff(c.ids, ids); diff != "" {
+				t.Errorf("-want, +got\n%s", diff)
+			}
+			if nextCursor != c.nextCursor {
+				t.Errorf("expected next cursor is %q, but got %q", c.nextCursor, nextCursor)
+			}
+			if requests != c.requests {
+				t.Errorf("expected number of requests is %d, but got %d", c.requests, requests)
+			}
+		})
+	}
+}
+
 var update = flag.Bool("update", false, "update golden files")
 
 const annictEndpoint = "https://api.annict.com/graphql"
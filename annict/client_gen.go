@@ -12,7 +12,34 @@ type Client struct {
 	Client *client.Client
 }
 type CreateRecordMutationPayload struct {
-	CreateRecord *struct{ ClientMutationID *string }
+	CreateRecord *struct {
+		ClientMutationID *string
+		Record           *struct{ ID string }
+	}
+}
+type CreateReviewMutationPayload struct {
+	CreateReview *struct {
+		Review *struct {
+			ID                   string
+			Body                 string
+			RatingOverallState   *RatingState
+			RatingAnimationState *RatingState
+			RatingMusicState     *RatingState
+			RatingStoryState     *RatingState
+			RatingCharacterState *RatingState
+			CreatedAt            string
+			Work                 *struct {
+				AnnictID int64
+				Title    string
+			}
+		}
+	}
+}
+type DeleteRecordMutationPayload struct {
+	DeleteRecord *struct{ ClientMutationID *string }
+}
+type DeleteReviewMutationPayload struct {
+	DeleteReview *struct{ ClientMutationID *string }
 }
 type GetProfile struct {
 	Viewer *struct {
@@ -34,6 +61,59 @@ type GetWork struct {
 		}
 	}
 }
+type GetWorkEpisodes struct {
+	SearchWorks *struct {
+		Edges []*struct {
+			Node *struct {
+				AnnictID int64
+				Episodes *struct {
+					Nodes []*struct {
+						ID          string
+						SortNumber  int64
+						NumberText  *string
+						Title       *string
+						NextEpisode *struct{ ID string }
+					}
+				}
+			}
+		}
+	}
+}
+type GetWorkImage struct {
+	SearchWorks *struct {
+		Edges []*struct {
+			Node *struct {
+				AnnictID int64
+				Image    *struct {
+					RecommendedImageURL    *string
+					FacebookOgImageURL     *string
+					TwitterBiggerAvatarURL *string
+					TwitterAvatarURL       *string
+				}
+			}
+		}
+	}
+}
+type ListAllWorks struct {
+	Viewer *struct {
+		Works *struct {
+			PageInfo struct {
+				HasNextPage bool
+				EndCursor   *string
+			}
+			Edges []*struct {
+				Node *struct {
+					AnnictID          int64
+					Title             string
+					SeasonYear        *int64
+					SeasonName        *SeasonName
+					EpisodesCount     int64
+					ViewerStatusState *StatusState
+				}
+			}
+		}
+	}
+}
 type ListNextEpisodes struct {
 	Viewer *struct {
 		Records *struct {
@@ -50,6 +130,7 @@ type ListNextEpisodes struct {
 						}
 						Work struct {
 							ID                string
+							AnnictID          int64
 							Title             string
 							ViewerStatusState *StatusState
 						}
@@ -59,12 +140,46 @@ type ListNextEpisodes struct {
 		}
 	}
 }
+type ListPrograms struct {
+	Viewer *struct {
+		Programs *struct {
+			PageInfo struct {
+				HasNextPage bool
+				EndCursor   *string
+			}
+			Edges []*struct {
+				Node *struct {
+					AnnictID    int64
+					StartedAt   string
+					Rebroadcast bool
+					State       ProgramState
+					Channel     struct{ Name string }
+					Episode     struct {
+						NumberText *string
+						Title      *string
+					}
+					Work struct {
+						AnnictID          int64
+						Title             string
+						ViewerStatusState *StatusState
+					}
+				}
+			}
+		}
+	}
+}
 type ListRecords struct {
 	Viewer *struct {
 		Records *struct {
+			PageInfo struct {
+				HasNextPage bool
+				EndCursor   *string
+			}
 			Edges []*struct {
 				Node *struct {
+					ID   string
 					Work struct {
+						AnnictID      int64
 						Title         string
 						EpisodesCount int64
 					}
@@ -79,6 +194,68 @@ type ListRecords struct {
 		}
 	}
 }
+type ListReviews struct {
+	Viewer *struct {
+		Activities *struct {
+			PageInfo struct {
+				HasNextPage bool
+				EndCursor   *string
+			}
+			Edges []*struct {
+				Cursor string
+				Node   struct {
+					Typename string `graphql:"__typename"`
+					Review   struct {
+						ID                   string
+						Body                 string
+						RatingOverallState   *RatingState
+						RatingAnimationState *RatingState
+						RatingMusicState     *RatingState
+						RatingStoryState     *RatingState
+						RatingCharacterState *RatingState
+						CreatedAt            string
+						Work                 *struct {
+							AnnictID int64
+							Title    string
+						}
+					} `graphql:"... on Review"`
+				}
+			}
+		}
+	}
+}
+type ListWorkStaffs struct {
+	Viewer *struct {
+		Works *struct {
+			PageInfo struct {
+				HasNextPage bool
+				EndCursor   *string
+			}
+			Edges []*struct {
+				Node *struct {
+					AnnictID int64
+					Title    string
+					Staffs   *struct {
+						Nodes []*struct {
+							RoleText string
+							Resource struct {
+								Typename     string `graphql:"__typename"`
+								Organization struct {
+									AnnictID int64
+									Name     string
+								} `graphql:"... on Organization"`
+								Person struct {
+									AnnictID int64
+									Name     string
+								} `graphql:"... on Person"`
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
 type ListWorks struct {
 	Viewer *struct {
 		Works *struct {
@@ -94,28 +271,68 @@ type ListWorks struct {
 					OfficialSiteURL   *string
 					WikipediaURL      *string
 					ViewerStatusState *StatusState
+					Image             *struct {
+						RecommendedImageURL    *string
+						FacebookOgImageURL     *string
+						TwitterBiggerAvatarURL *string
+						TwitterAvatarURL       *string
+					}
 				}
 			}
 		}
 	}
 }
-type UpdateStatusMutationPayload struct {
-	UpdateStatus *struct{ ClientMutationID *string }
+type SearchWorks struct {
+	SearchWorks *struct {
+		Nodes []*struct {
+			AnnictID          int64
+			Title             string
+			TitleKana         *string
+			TitleEn           *string
+			SeasonYear        *int64
+			SeasonName        *SeasonName
+			EpisodesCount     int64
+			ViewerStatusState *StatusState
+		}
+	}
 }
-type UpdateWorkStatusPayload struct {
+type UpdateReviewMutationPayload struct {
+	UpdateReview *struct {
+		Review *struct {
+			ID                   string
+			Body                 string
+			RatingOverallState   *RatingState
+			RatingAnimationState *RatingState
+			RatingMusicState     *RatingState
+			RatingStoryState     *RatingState
+			RatingCharacterState *RatingState
+			CreatedAt            string
+			Work                 *struct {
+				AnnictID int64
+				Title    string
+			}
+		}
+	}
+}
+type UpdateStatusMutationPayload struct {
 	UpdateStatus *struct{ ClientMutationID *string }
 }
 
-const CreateRecordMutationQuery = `mutation CreateRecordMutation ($episodeId: ID!) {
-	createRecord(input: {episodeId:$episodeId}) {
+const CreateRecordMutationQuery = `mutation CreateRecordMutation ($episodeId: ID!, $comment: String, $ratingState: RatingState) {
+	createRecord(input: {episodeId:$episodeId,comment:$comment,ratingState:$ratingState}) {
 		clientMutationId
+		record {
+			id
+		}
 	}
 }
 `
 
-func (c *Client) CreateRecordMutation(ctx context.Context, episodeID string, httpRequestOptions ...client.HTTPRequestOption) (*CreateRecordMutationPayload, error) {
+func (c *Client) CreateRecordMutation(ctx context.Context, episodeID string, comment *string, ratingState *RatingState, httpRequestOptions ...client.HTTPRequestOption) (*CreateRecordMutationPayload, error) {
 	vars := map[string]interface{}{
-		"episodeId": episodeID,
+		"episodeId":   episodeID,
+		"comment":     comment,
+		"ratingState": ratingState,
 	}
 
 	var res CreateRecordMutationPayload
@@ -126,6 +343,85 @@ func (c *Client) CreateRecordMutation(ctx context.Context, episodeID string, htt
 	return &res, nil
 }
 
+const CreateReviewMutationQuery = `mutation CreateReviewMutation ($workId: ID!, $body: String!, $ratingOverallState: RatingState, $ratingAnimationState: RatingState, $ratingMusicState: RatingState, $ratingStoryState: RatingState, $ratingCharacterState: RatingState) {
+	createReview(input: {workId:$workId,body:$body,ratingOverallState:$ratingOverallState,ratingAnimationState:$ratingAnimationState,ratingMusicState:$ratingMusicState,ratingStoryState:$ratingStoryState,ratingCharacterState:$ratingCharacterState}) {
+		review {
+			id
+			body
+			ratingOverallState
+			ratingAnimationState
+			ratingMusicState
+			ratingStoryState
+			ratingCharacterState
+			createdAt
+			work {
+				annictId
+				title
+			}
+		}
+	}
+}
+`
+
+func (c *Client) CreateReviewMutation(ctx context.Context, workID string, body string, ratingOverallState *RatingState, ratingAnimationState *RatingState, ratingMusicState *RatingState, ratingStoryState *RatingState, ratingCharacterState *RatingState, httpRequestOptions ...client.HTTPRequestOption) (*CreateReviewMutationPayload, error) {
+	vars := map[string]interface{}{
+		"workId":               workID,
+		"body":                 body,
+		"ratingOverallState":   ratingOverallState,
+		"ratingAnimationState": ratingAnimationState,
+		"ratingMusicState":     ratingMusicState,
+		"ratingStoryState":     ratingStoryState,
+		"ratingCharacterState": ratingCharacterState,
+	}
+
+	var res CreateReviewMutationPayload
+	if err := c.Client.Post(ctx, CreateReviewMutationQuery, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const DeleteRecordMutationQuery = `mutation DeleteRecordMutation ($recordId: ID!) {
+	deleteRecord(input: {recordId:$recordId}) {
+		clientMutationId
+	}
+}
+`
+
+func (c *Client) DeleteRecordMutation(ctx context.Context, recordID string, httpRequestOptions ...client.HTTPRequestOption) (*DeleteRecordMutationPayload, error) {
+	vars := map[string]interface{}{
+		"recordId": recordID,
+	}
+
+	var res DeleteRecordMutationPayload
+	if err := c.Client.Post(ctx, DeleteRecordMutationQuery, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const DeleteReviewMutationQuery = `mutation DeleteReviewMutation ($reviewId: ID!) {
+	deleteReview(input: {reviewId:$reviewId}) {
+		clientMutationId
+	}
+}
+`
+
+func (c *Client) DeleteReviewMutation(ctx context.Context, reviewID string, httpRequestOptions ...client.HTTPRequestOption) (*DeleteReviewMutationPayload, error) {
+	vars := map[string]interface{}{
+		"reviewId": reviewID,
+	}
+
+	var res DeleteReviewMutationPayload
+	if err := c.Client.Post(ctx, DeleteReviewMutationQuery, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const GetProfileQuery = `query GetProfile {
 	viewer {
 		avatarUrl
@@ -178,6 +474,108 @@ func (c *Client) GetWork(ctx context.Context, ids []int64, httpRequestOptions ..
 	return &res, nil
 }
 
+const GetWorkEpisodesQuery = `query GetWorkEpisodes ($ids: [Int!]) {
+	searchWorks(annictIds: $ids) {
+		edges {
+			node {
+				annictId
+				episodes(orderBy: {direction:ASC,field:SORT_NUMBER}) {
+					nodes {
+						id
+						sortNumber
+						numberText
+						title
+						nextEpisode {
+							id
+						}
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+func (c *Client) GetWorkEpisodes(ctx context.Context, ids []int64, httpRequestOptions ...client.HTTPRequestOption) (*GetWorkEpisodes, error) {
+	vars := map[string]interface{}{
+		"ids": ids,
+	}
+
+	var res GetWorkEpisodes
+	if err := c.Client.Post(ctx, GetWorkEpisodesQuery, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetWorkImageQuery = `query GetWorkImage ($ids: [Int!]) {
+	searchWorks(annictIds: $ids) {
+		edges {
+			node {
+				annictId
+				image {
+					recommendedImageUrl
+					facebookOgImageUrl
+					twitterBiggerAvatarUrl
+					twitterAvatarUrl
+				}
+			}
+		}
+	}
+}
+`
+
+func (c *Client) GetWorkImage(ctx context.Context, ids []int64, httpRequestOptions ...client.HTTPRequestOption) (*GetWorkImage, error) {
+	vars := map[string]interface{}{
+		"ids": ids,
+	}
+
+	var res GetWorkImage
+	if err := c.Client.Post(ctx, GetWorkImageQuery, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const ListAllWorksQuery = `query ListAllWorks ($state: StatusState, $after: String, $n: Int!) {
+	viewer {
+		works(state: $state, after: $after, first: $n, orderBy: {direction:DESC,field:SEASON}) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			edges {
+				node {
+					annictId
+					title
+					seasonYear
+					seasonName
+					episodesCount
+					viewerStatusState
+				}
+			}
+		}
+	}
+}
+`
+
+func (c *Client) ListAllWorks(ctx context.Context, state *StatusState, after *string, n int64, httpRequestOptions ...client.HTTPRequestOption) (*ListAllWorks, error) {
+	vars := map[string]interface{}{
+		"state": state,
+		"after": after,
+		"n":     n,
+	}
+
+	var res ListAllWorks
+	if err := c.Client.Post(ctx, ListAllWorksQuery, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const ListNextEpisodesQuery = `query ListNextEpisodes {
 	viewer {
 		records {
@@ -196,6 +594,7 @@ const ListNextEpisodesQuery = `query ListNextEpisodes {
 						}
 						work {
 							id
+							annictId
 							title
 							viewerStatusState
 						}
@@ -218,12 +617,64 @@ func (c *Client) ListNextEpisodes(ctx context.Context, httpRequestOptions ...cli
 	return &res, nil
 }
 
-const ListRecordsQuery = `query listRecords {
+const ListProgramsQuery = `query ListPrograms ($after: String, $n: Int!) {
 	viewer {
-		records {
+		programs(unwatched: true, after: $after, first: $n, orderBy: {direction:DESC,field:STARTED_AT}) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			edges {
+				node {
+					annictId
+					startedAt
+					rebroadcast
+					state
+					channel {
+						name
+					}
+					episode {
+						numberText
+						title
+					}
+					work {
+						annictId
+						title
+						viewerStatusState
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+func (c *Client) ListPrograms(ctx context.Context, after *string, n int64, httpRequestOptions ...client.HTTPRequestOption) (*ListPrograms, error) {
+	vars := map[string]interface{}{
+		"after": after,
+		"n":     n,
+	}
+
+	var res ListPrograms
+	if err := c.Client.Post(ctx, ListProgramsQuery, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const ListRecordsQuery = `query listRecords ($after: String, $n: Int!) {
+	viewer {
+		records(after: $after, first: $n, orderBy: {direction:DESC,field:CREATED_AT}) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
 			edges {
 				node {
+					id
 					work {
+						annictId
 						title
 						episodesCount
 					}
@@ -242,8 +693,11 @@ const ListRecordsQuery = `query listRecords {
 }
 `
 
-func (c *Client) ListRecords(ctx context.Context, httpRequestOptions ...client.HTTPRequestOption) (*ListRecords, error) {
-	vars := map[string]interface{}{}
+func (c *Client) ListRecords(ctx context.Context, after *string, n int64, httpRequestOptions ...client.HTTPRequestOption) (*ListRecords, error) {
+	vars := map[string]interface{}{
+		"after": after,
+		"n":     n,
+	}
 
 	var res ListRecords
 	if err := c.Client.Post(ctx, ListRecordsQuery, &res, vars, httpRequestOptions...); err != nil {
@@ -253,6 +707,101 @@ func (c *Client) ListRecords(ctx context.Context, httpRequestOptions ...client.H
 	return &res, nil
 }
 
+const ListReviewsQuery = `query ListReviews ($after: String, $n: Int!) {
+	viewer {
+		activities(after: $after, first: $n, orderBy: {direction:DESC,field:CREATED_AT}) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			edges {
+				cursor
+				node {
+					__typename
+					... on Review {
+						id
+						body
+						ratingOverallState
+						ratingAnimationState
+						ratingMusicState
+						ratingStoryState
+						ratingCharacterState
+						createdAt
+						work {
+							annictId
+							title
+						}
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+func (c *Client) ListReviews(ctx context.Context, after *string, n int64, httpRequestOptions ...client.HTTPRequestOption) (*ListReviews, error) {
+	vars := map[string]interface{}{
+		"after": after,
+		"n":     n,
+	}
+
+	var res ListReviews
+	if err := c.Client.Post(ctx, ListReviewsQuery, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const ListWorkStaffsQuery = `query ListWorkStaffs ($state: StatusState, $after: String, $n: Int!) {
+	viewer {
+		works(state: $state, after: $after, first: $n, orderBy: {direction:DESC,field:SEASON}) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			edges {
+				node {
+					annictId
+					title
+					staffs(first: 100, orderBy: {direction:ASC,field:SORT_NUMBER}) {
+						nodes {
+							roleText
+							resource {
+								__typename
+								... on Organization {
+									annictId
+									name
+								}
+								... on Person {
+									annictId
+									name
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+func (c *Client) ListWorkStaffs(ctx context.Context, state *StatusState, after *string, n int64, httpRequestOptions ...client.HTTPRequestOption) (*ListWorkStaffs, error) {
+	vars := map[string]interface{}{
+		"state": state,
+		"after": after,
+		"n":     n,
+	}
+
+	var res ListWorkStaffs
+	if err := c.Client.Post(ctx, ListWorkStaffsQuery, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const ListWorksQuery = `query ListWorks ($state: StatusState, $after: String, $n: Int!) {
 	viewer {
 		works(state: $state, after: $after, first: $n, orderBy: {direction:DESC,field:SEASON}) {
@@ -268,6 +817,12 @@ const ListWorksQuery = `query ListWorks ($state: StatusState, $after: String, $n
 					officialSiteUrl
 					wikipediaUrl
 					viewerStatusState
+					image {
+						recommendedImageUrl
+						facebookOgImageUrl
+						twitterBiggerAvatarUrl
+						twitterAvatarUrl
+					}
 				}
 			}
 		}
@@ -290,41 +845,90 @@ func (c *Client) ListWorks(ctx context.Context, state *StatusState, after *strin
 	return &res, nil
 }
 
-const UpdateStatusMutationQuery = `mutation UpdateStatusMutation ($state: StatusState!, $workId: ID!) {
-	updateStatus(input: {state:$state,workId:$workId}) {
-		clientMutationId
+const SearchWorksQuery = `query SearchWorks ($titles: [String!], $n: Int!) {
+	searchWorks(titles: $titles, first: $n, orderBy: {direction:DESC,field:WATCHERS_COUNT}) {
+		nodes {
+			annictId
+			title
+			titleKana
+			titleEn
+			seasonYear
+			seasonName
+			episodesCount
+			viewerStatusState
+		}
 	}
 }
 `
 
-func (c *Client) UpdateStatusMutation(ctx context.Context, state StatusState, workID string, httpRequestOptions ...client.HTTPRequestOption) (*UpdateStatusMutationPayload, error) {
+func (c *Client) SearchWorks(ctx context.Context, titles []string, n int64, httpRequestOptions ...client.HTTPRequestOption) (*SearchWorks, error) {
 	vars := map[string]interface{}{
-		"state":  state,
-		"workId": workID,
+		"titles": titles,
+		"n":      n,
 	}
 
-	var res UpdateStatusMutationPayload
-	if err := c.Client.Post(ctx, UpdateStatusMutationQuery, &res, vars, httpRequestOptions...); err != nil {
+	var res SearchWorks
+	if err := c.Client.Post(ctx, SearchWorksQuery, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const UpdateReviewMutationQuery = `mutation UpdateReviewMutation ($reviewId: ID!, $body: String!, $ratingOverallState: RatingState!, $ratingAnimationState: RatingState!, $ratingMusicState: RatingState!, $ratingStoryState: RatingState!, $ratingCharacterState: RatingState!) {
+	updateReview(input: {reviewId:$reviewId,body:$body,ratingOverallState:$ratingOverallState,ratingAnimationState:$ratingAnimationState,ratingMusicState:$ratingMusicState,ratingStoryState:$ratingStoryState,ratingCharacterState:$ratingCharacterState}) {
+		review {
+			id
+			body
+			ratingOverallState
+			ratingAnimationState
+			ratingMusicState
+			ratingStoryState
+			ratingCharacterState
+			createdAt
+			work {
+				annictId
+				title
+			}
+		}
+	}
+}
+`
+
+func (c *Client) UpdateReviewMutation(ctx context.Context, reviewID string, body string, ratingOverallState RatingState, ratingAnimationState RatingState, ratingMusicState RatingState, ratingStoryState RatingState, ratingCharacterState RatingState, httpRequestOptions ...client.HTTPRequestOption) (*UpdateReviewMutationPayload, error) {
+	vars := map[string]interface{}{
+		"reviewId":             reviewID,
+		"body":                 body,
+		"ratingOverallState":   ratingOverallState,
+		"ratingAnimationState": ratingAnimationState,
+		"ratingMusicState":     ratingMusicState,
+		"ratingStoryState":     ratingStoryState,
+		"ratingCharacterState": ratingCharacterState,
+	}
+
+	var res UpdateReviewMutationPayload
+	if err := c.Client.Post(ctx, UpdateReviewMutationQuery, &res, vars, httpRequestOptions...); err != nil {
 		return nil, err
 	}
 
 	return &res, nil
 }
 
-const UpdateWorkStatusQuery = `mutation UpdateWorkStatus ($workId: ID!) {
-	updateStatus(input: {state:WATCHING,workId:$workId}) {
+const UpdateStatusMutationQuery = `mutation UpdateStatusMutation ($state: StatusState!, $workId: ID!) {
+	updateStatus(input: {state:$state,workId:$workId}) {
 		clientMutationId
 	}
 }
 `
 
-func (c *Client) UpdateWorkStatus(ctx context.Context, workID string, httpRequestOptions ...client.HTTPRequestOption) (*UpdateWorkStatusPayload, error) {
+func (c *Client) UpdateStatusMutation(ctx context.Context, state StatusState, workID string, httpRequestOptions ...client.HTTPRequestOption) (*UpdateStatusMutationPayload, error) {
 	vars := map[string]interface{}{
+		"state":  state,
 		"workId": workID,
 	}
 
-	var res UpdateWorkStatusPayload
-	if err := c.Client.Post(ctx, UpdateWorkStatusQuery, &res, vars, httpRequestOptions...); err != nil {
+	var res UpdateStatusMutationPayload
+	if err := c.Client.Post(ctx, UpdateStatusMutationQuery, &res, vars, httpRequestOptions...); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,66 @@
+package annict
+
+import (
+	"context"
+
+	"github.com/morikuni/failure"
+)
+
+// workImage holds candidates of the image URL of a work.
+type workImage struct {
+	workID int32
+	// urls are image URLs provided by Annict in order of preference.
+	urls []string
+}
+
+// imageURLs returns non-nil URLs of urls.
+func imageURLs(urls ...*string) []string {
+	res := make([]string, 0, len(urls))
+	for _, url := range urls {
+		if url != nil {
+			res = append(res, *url)
+		}
+	}
+	return res
+}
+
+// imageResolver resolves the image URL of a work.
+// resolve returns an empty string if the resolver cannot find the URL.
+type imageResolver interface {
+	resolve(ctx context.Context, img *workImage) (string, error)
+}
+
+// imageResolverChain tries resolvers in order and returns the first resolved URL.
+type imageResolverChain []imageResolver
+
+func (c imageResolverChain) resolve(ctx context.Context, img *workImage) (string, error) {
+	for _, r := range c {
+		url, err := r.resolve(ctx, img)
+		if err != nil {
+			return "", failure.Wrap(err)
+		}
+		if url != "" {
+			return url, nil
+		}
+	}
+	return "", nil
+}
+
+// annictImageResolver resolves the URL from the image fields provided by the Annict API.
+type annictImageResolver struct{}
+
+func (annictImageResolver) resolve(_ context.Context, img *workImage) (string, error) {
+	for _, url := range img.urls {
+		if url != "" {
+			return url, nil
+		}
+	}
+	return "", nil
+}
+
+// placeholderImageResolver always resolves to the fixed URL.
+type placeholderImageResolver string
+
+func (r placeholderImageResolver) resolve(context.Context, *workImage) (string, error) {
+	return string(r), nil
+}
@@ -0,0 +1,142 @@
+package annict
+
+import (
+	"container/list"
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/GoodCodingFriends/animekai/errors"
+	"github.com/GoodCodingFriends/animekai/store"
+	"github.com/morikuni/failure"
+)
+
+const (
+	ogImagesBucket = "og_images"
+
+	defaultImageCacheCapacity    = 512
+	defaultImageCacheTTL         = 24 * time.Hour
+	defaultImageCacheNegativeTTL = 10 * time.Minute
+)
+
+type imageCacheEntry struct {
+	URL string `json:"url"`
+	// Failed is true if fetching the image URL was failed.
+	// Failed entries are cached for a shorter time than succeeded ones.
+	Failed    bool      `json:"failed"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type lruItem struct {
+	workID int32
+	entry  *imageCacheEntry
+}
+
+// imageCache caches image URLs for works.
+// Entries are kept in an in-memory LRU cache. If a store is specified, entries are also persisted to it
+// so that they survive restarts.
+type imageCache struct {
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List
+	items    map[int32]*list.Element
+
+	// store is nil if entries are not persisted.
+	store       store.Store
+	ttl         time.Duration
+	negativeTTL time.Duration
+
+	now func() time.Time
+}
+
+func newImageCache(st store.Store, ttl time.Duration) *imageCache {
+	return &imageCache{
+		capacity:    defaultImageCacheCapacity,
+		ll:          list.New(),
+		items:       map[int32]*list.Element{},
+		store:       st,
+		ttl:         ttl,
+		negativeTTL: defaultImageCacheNegativeTTL,
+		now:         time.Now,
+	}
+}
+
+// get returns the cached entry for workID. ok is false if no entry is cached or the entry is expired.
+func (c *imageCache) get(workID int32) (_ *imageCacheEntry, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.items[workID]; ok {
+		item := e.Value.(*lruItem)
+		if c.now().Before(item.entry.ExpiresAt) {
+			c.ll.MoveToFront(e)
+			return item.entry, true
+		}
+		c.ll.Remove(e)
+		delete(c.items, workID)
+	}
+
+	if c.store == nil {
+		return nil, false
+	}
+
+	b, err := c.store.Get(ogImagesBucket, imageKey(workID))
+	if err != nil {
+		return nil, false
+	}
+	var entry imageCacheEntry
+	if err := json.Unmarshal(b, &entry); err != nil {
+		return nil, false
+	}
+	if !c.now().Before(entry.ExpiresAt) {
+		_ = c.store.Delete(ogImagesBucket, imageKey(workID))
+		return nil, false
+	}
+	c.addLocked(workID, &entry)
+	return &entry, true
+}
+
+// set caches url for workID. If fetchErr is not nil, the failure is cached instead of url.
+func (c *imageCache) set(workID int32, url string, fetchErr error) error {
+	entry := &imageCacheEntry{URL: url, ExpiresAt: c.now().Add(c.ttl)}
+	if fetchErr != nil || url == "" {
+		entry = &imageCacheEntry{Failed: true, ExpiresAt: c.now().Add(c.negativeTTL)}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.addLocked(workID, entry)
+
+	if c.store == nil {
+		return nil
+	}
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return failure.Translate(err, errors.Internal)
+	}
+	if err := c.store.Put(ogImagesBucket, imageKey(workID), b); err != nil {
+		return failure.Wrap(err)
+	}
+	return nil
+}
+
+func (c *imageCache) addLocked(workID int32, entry *imageCacheEntry) {
+	if e, ok := c.items[workID]; ok {
+		e.Value.(*lruItem).entry = entry
+		c.ll.MoveToFront(e)
+		return
+	}
+
+	c.items[workID] = c.ll.PushFront(&lruItem{workID: workID, entry: entry})
+	if c.ll.Len() > c.capacity {
+		oldest := c.ll.Back()
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruItem).workID)
+	}
+}
+
+func imageKey(workID int32) string {
+	return strconv.FormatInt(int64(workID), 10)
+}
@@ -0,0 +1,56 @@
+package annict
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GoodCodingFriends/animekai/store"
+)
+
+func TestImageCache(t *testing.T) {
+	now := time.Date(2020, 7, 1, 0, 0, 0, 0, JST)
+	st := store.NewMemory()
+	c := newImageCache(st, time.Hour)
+	c.capacity = 2
+	c.now = func() time.Time { return now }
+
+	if err := c.set(1, "https://example.com/1.png", nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.set(2, "", errors.New("timeout")); err != nil {
+		t.Fatal(err)
+	}
+
+	if e, ok := c.get(1); !ok || e.URL != "https://example.com/1.png" {
+		t.Errorf("expected cached URL, but got %+v, %t", e, ok)
+	}
+	if e, ok := c.get(2); !ok || !e.Failed {
+		t.Errorf("expected negative cache entry, but got %+v, %t", e, ok)
+	}
+
+	// Negative entries expire earlier.
+	now = now.Add(defaultImageCacheNegativeTTL)
+	if _, ok := c.get(2); ok {
+		t.Errorf("negative cache entry should be expired")
+	}
+
+	// Evicted entries are restored from the store.
+	if err := c.set(3, "https://example.com/3.png", nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.set(4, "https://example.com/4.png", nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := c.items[1]; ok {
+		t.Errorf("the least recently used entry should be evicted")
+	}
+	if e, ok := c.get(1); !ok || e.URL != "https://example.com/1.png" {
+		t.Errorf("expected URL restored from the store, but got %+v, %t", e, ok)
+	}
+
+	now = now.Add(time.Hour)
+	if _, ok := c.get(1); ok {
+		t.Errorf("cache entry should be expired")
+	}
+}
@@ -0,0 +1,110 @@
+package annict
+
+import (
+	"context"
+	"time"
+
+	"github.com/GoodCodingFriends/animekai/errors"
+	"github.com/GoodCodingFriends/animekai/resource"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/morikuni/failure"
+	"go.uber.org/zap"
+)
+
+const (
+	programsPageSize = 50
+	// maxProgramPages limits pages of programs scheduled after now, which are fetched before upcoming ones because
+	// programs are listed in reverse chronological order.
+	maxProgramPages = 10
+)
+
+func (s *service) ListUpcomingPrograms(ctx context.Context, within time.Duration) ([]*resource.Program, error) {
+	if within <= 0 {
+		return nil, failure.New(errors.InvalidArgument, failure.Message("within must be positive"))
+	}
+
+	now := time.Now()
+	until := now.Add(within)
+
+	var (
+		programs []*resource.Program
+		after    *string
+	)
+	for page := 0; ; page++ {
+		if page == maxProgramPages {
+			ctxzap.Extract(ctx).Warn("reached the max number of program pages", zap.Int("max_program_pages", maxProgramPages))
+			break
+		}
+
+		res, err := s.client.ListPrograms(ctx, after, programsPageSize)
+		if err != nil {
+			return nil, convertError(err)
+		}
+
+		ps, started, err := upcomingPrograms(res, now, until)
+		if err != nil {
+			return nil, failure.Wrap(err)
+		}
+		programs = append(programs, ps...)
+		if started {
+			break
+		}
+
+		pageInfo := res.Viewer.Programs.PageInfo
+		if !pageInfo.HasNextPage || pageInfo.EndCursor == nil {
+			break
+		}
+		after = pageInfo.EndCursor
+	}
+
+	// Programs are fetched in reverse chronological order.
+	for i, j := 0, len(programs)-1; i < j; i, j = i+1, j-1 {
+		programs[i], programs[j] = programs[j], programs[i]
+	}
+	return programs, nil
+}
+
+// upcomingPrograms returns published programs of watching works in a page which start in [now, until).
+// The second result reports whether the page reaches programs started before now.
+func upcomingPrograms(res *ListPrograms, now, until time.Time) ([]*resource.Program, bool, error) {
+	var programs []*resource.Program
+	for _, e := range res.Viewer.Programs.Edges {
+		n := e.Node
+		startedAt, err := time.Parse(time.RFC3339, n.StartedAt)
+		if err != nil {
+			return nil, false, convertError(err)
+		}
+		if startedAt.Before(now) {
+			// Remaining programs are already started.
+			return programs, true, nil
+		}
+		if !startedAt.Before(until) || n.State != ProgramStatePublished {
+			continue
+		}
+		if n.Work.ViewerStatusState == nil || *n.Work.ViewerStatusState != StatusStateWatching {
+			continue
+		}
+
+		startTime, err := ptypes.TimestampProto(startedAt)
+		if err != nil {
+			return nil, false, failure.Translate(err, errors.Internal, failure.Context{"started_at": n.StartedAt})
+		}
+		p := &resource.Program{
+			Id:          int32(n.AnnictID),
+			WorkId:      int32(n.Work.AnnictID),
+			WorkTitle:   n.Work.Title,
+			ChannelName: n.Channel.Name,
+			Rebroadcast: n.Rebroadcast,
+			StartTime:   startTime,
+		}
+		if n.Episode.NumberText != nil {
+			p.EpisodeNumberText = *n.Episode.NumberText
+		}
+		if n.Episode.Title != nil {
+			p.EpisodeTitle = *n.Episode.Title
+		}
+		programs = append(programs, p)
+	}
+	return programs, false, nil
+}
@@ -0,0 +1,304 @@
+package annict
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/GoodCodingFriends/animekai/errors"
+	"github.com/GoodCodingFriends/animekai/store"
+	"github.com/morikuni/failure"
+)
+
+const (
+	recordsBucket      = "records"
+	recordsMetaBucket  = "records_meta"
+	latestCreatedAtKey = "latest_created_at"
+)
+
+// record is a flattened record of viewer.records.
+type record struct {
+	ID                string    `json:"id"`
+	WorkID            int64     `json:"work_id"`
+	WorkTitle         string    `json:"work_title"`
+	EpisodeSortNumber int64     `json:"episode_sort_number"`
+	HasNextEpisode    bool      `json:"has_next_episode"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+// recordCache persists viewer's records to a store.Store.
+// Records are grouped by Annict work IDs.
+type recordCache struct {
+	mu    sync.RWMutex
+	store store.Store
+}
+
+func newRecordCache(s store.Store) *recordCache {
+	return &recordCache{store: s}
+}
+
+// latestCreatedAt returns the creation time of the newest cached record.
+// It returns the zero time if no records are cached.
+func (c *recordCache) latestCreatedAt() (time.Time, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	b, err := c.store.Get(recordsMetaBucket, latestCreatedAtKey)
+	if failure.Is(err, errors.NotFound) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, failure.Wrap(err)
+	}
+
+	t, err := time.Parse(time.RFC3339, string(b))
+	if err != nil {
+		return time.Time{}, failure.Translate(err, errors.Internal)
+	}
+	return t, nil
+}
+
+// add merges records into the cache. Records which are already cached are ignored.
+func (c *recordCache) add(records []*record) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	byWork := map[int64][]*record{}
+	latest := time.Time{}
+	for _, r := range records {
+		byWork[r.WorkID] = append(byWork[r.WorkID], r)
+		if r.CreatedAt.After(latest) {
+			latest = r.CreatedAt
+		}
+	}
+
+	for workID, newRecords := range byWork {
+		if err := c.mergeWorkRecords(workID, newRecords); err != nil {
+			return failure.Wrap(err)
+		}
+	}
+
+	b, err := c.store.Get(recordsMetaBucket, latestCreatedAtKey)
+	if err != nil && !failure.Is(err, errors.NotFound) {
+		return failure.Wrap(err)
+	}
+	if err == nil {
+		t, err := time.Parse(time.RFC3339, string(b))
+		if err == nil && t.After(latest) {
+			return nil
+		}
+	}
+	if err := c.store.Put(recordsMetaBucket, latestCreatedAtKey, []byte(latest.Format(time.RFC3339))); err != nil {
+		return failure.Wrap(err)
+	}
+	return nil
+}
+
+// mergeWorkRecords merges records of a work into the cached ones. c.mu must be held.
+func (c *recordCache) mergeWorkRecords(workID int64, records []*record) error {
+	cached, err := c.getWorkRecords(workID)
+	if err != nil {
+		return failure.Wrap(err)
+	}
+
+	known := make(map[string]struct{}, len(cached))
+	for _, r := range cached {
+		known[r.ID] = struct{}{}
+	}
+	for _, r := range records {
+		if _, ok := known[r.ID]; ok {
+			continue
+		}
+		known[r.ID] = struct{}{}
+		cached = append(cached, r)
+	}
+	sort.Slice(cached, func(i, j int) bool {
+		return cached[i].CreatedAt.Before(cached[j].CreatedAt)
+	})
+	fixLastEpisodes(cached)
+
+	b, err := json.Marshal(cached)
+	if err != nil {
+		return failure.Translate(err, errors.Internal)
+	}
+	if err := c.store.Put(recordsBucket, workKey(workID), b); err != nil {
+		return failure.Wrap(err)
+	}
+	return nil
+}
+
+// fixLastEpisodes clears the last episode flags of records followed by records of later episodes.
+// HasNextEpisode is resolved when the record is fetched, so it is stale if the next episode was registered after that.
+func fixLastEpisodes(records []*record) {
+	var maxSortNumber int64
+	for _, r := range records {
+		if r.EpisodeSortNumber > maxSortNumber {
+			maxSortNumber = r.EpisodeSortNumber
+		}
+	}
+	for _, r := range records {
+		if r.EpisodeSortNumber < maxSortNumber {
+			r.HasNextEpisode = true
+		}
+	}
+}
+
+// lastEpisodeRecords returns the records of the latest episode of each work which are regarded as the last episode
+// and created after since.
+func (c *recordCache) lastEpisodeRecords(since time.Time) ([]*record, error) {
+	byWork, err := c.list()
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+
+	var res []*record
+	for _, records := range byWork {
+		latest := records[0]
+		for _, r := range records {
+			if r.EpisodeSortNumber >= latest.EpisodeSortNumber {
+				latest = r
+			}
+		}
+		if !latest.HasNextEpisode && latest.CreatedAt.After(since) {
+			res = append(res, latest)
+		}
+	}
+	return res, nil
+}
+
+// markHasNextEpisode marks all records of the works identified by workIDs as having next episodes.
+func (c *recordCache) markHasNextEpisode(workIDs []int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, workID := range workIDs {
+		records, err := c.getWorkRecords(workID)
+		if err != nil {
+			return failure.Wrap(err)
+		}
+		if len(records) == 0 {
+			continue
+		}
+		for _, r := range records {
+			r.HasNextEpisode = true
+		}
+		b, err := json.Marshal(records)
+		if err != nil {
+			return failure.Translate(err, errors.Internal)
+		}
+		if err := c.store.Put(recordsBucket, workKey(workID), b); err != nil {
+			return failure.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// remove removes records identified by ids from the cache.
+func (c *recordCache) remove(ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	removed := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		removed[id] = struct{}{}
+	}
+
+	updated := map[string][]*record{}
+	err := c.store.ForEach(recordsBucket, func(key string, value []byte) error {
+		var records []*record
+		if err := json.Unmarshal(value, &records); err != nil {
+			return failure.Translate(err, errors.Internal, failure.Context{"work_id": key})
+		}
+		n := 0
+		for _, r := range records {
+			if _, ok := removed[r.ID]; !ok {
+				records[n] = r
+				n++
+			}
+		}
+		if n != len(records) {
+			updated[key] = records[:n]
+		}
+		return nil
+	})
+	if err != nil {
+		return failure.Wrap(err)
+	}
+
+	for key, records := range updated {
+		b, err := json.Marshal(records)
+		if err != nil {
+			return failure.Translate(err, errors.Internal)
+		}
+		if err := c.store.Put(recordsBucket, key, b); err != nil {
+			return failure.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// list returns all cached records grouped by Annict work IDs. Records of each work are in chronological order.
+func (c *recordCache) list() (map[int64][]*record, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	byWork := map[int64][]*record{}
+	err := c.store.ForEach(recordsBucket, func(key string, value []byte) error {
+		var records []*record
+		if err := json.Unmarshal(value, &records); err != nil {
+			return failure.Translate(err, errors.Internal, failure.Context{"work_id": key})
+		}
+		for _, r := range records {
+			r.CreatedAt = r.CreatedAt.In(JST)
+		}
+		if len(records) != 0 {
+			byWork[records[0].WorkID] = records
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+	return byWork, nil
+}
+
+// workRecords returns cached records of the work identified by workID in chronological order.
+func (c *recordCache) workRecords(workID int64) ([]*record, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.getWorkRecords(workID)
+}
+
+func (c *recordCache) getWorkRecords(workID int64) ([]*record, error) {
+	b, err := c.store.Get(recordsBucket, workKey(workID))
+	if failure.Is(err, errors.NotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+
+	var records []*record
+	if err := json.Unmarshal(b, &records); err != nil {
+		return nil, failure.Translate(err, errors.Internal, failure.Context{"work_id": workKey(workID)})
+	}
+	for _, r := range records {
+		r.CreatedAt = r.CreatedAt.In(JST)
+	}
+	return records, nil
+}
+
+func workKey(workID int64) string {
+	return strconv.FormatInt(workID, 10)
+}
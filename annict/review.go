@@ -0,0 +1,227 @@
+package annict
+
+import (
+	"context"
+	"time"
+
+	"github.com/GoodCodingFriends/animekai/errors"
+	"github.com/GoodCodingFriends/animekai/resource"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/morikuni/failure"
+	"go.uber.org/zap"
+)
+
+const (
+	// reviewTypename is the GraphQL type name of reviews in viewer.activities.
+	reviewTypename = "Review"
+
+	reviewActivitiesPageSize = 50
+	// maxReviewPages limits pages of activities fetched per ListReviews because activities are mostly records.
+	maxReviewPages = 10
+)
+
+// reviewNode is the review selected by review queries and mutations.
+type reviewNode struct {
+	ID                   string
+	Body                 string
+	RatingOverallState   *RatingState
+	RatingAnimationState *RatingState
+	RatingMusicState     *RatingState
+	RatingStoryState     *RatingState
+	RatingCharacterState *RatingState
+	CreatedAt            string
+	Work                 *struct {
+		AnnictID int64
+		Title    string
+	}
+}
+
+func (n *reviewNode) resource() (*resource.Review, error) {
+	r := &resource.Review{
+		Id:              n.ID,
+		Body:            n.Body,
+		OverallRating:   toResourceRating(n.RatingOverallState),
+		AnimationRating: toResourceRating(n.RatingAnimationState),
+		MusicRating:     toResourceRating(n.RatingMusicState),
+		StoryRating:     toResourceRating(n.RatingStoryState),
+		CharacterRating: toResourceRating(n.RatingCharacterState),
+	}
+	if n.Work != nil {
+		r.WorkId = int32(n.Work.AnnictID)
+		r.WorkTitle = n.Work.Title
+	}
+
+	createdAt, err := time.Parse(time.RFC3339, n.CreatedAt)
+	if err != nil {
+		return nil, failure.Translate(err, errors.Internal, failure.Context{"created_at": n.CreatedAt})
+	}
+	r.CreateTime, err = ptypes.TimestampProto(createdAt)
+	if err != nil {
+		return nil, failure.Translate(err, errors.Internal, failure.Context{"created_at": n.CreatedAt})
+	}
+	return r, nil
+}
+
+func toResourceRating(s *RatingState) resource.Review_Rating {
+	if s == nil {
+		return resource.Review_RATING_UNSPECIFIED
+	}
+	switch *s {
+	case RatingStateBad:
+		return resource.Review_BAD
+	case RatingStateAverage:
+		return resource.Review_AVERAGE
+	case RatingStateGood:
+		return resource.Review_GOOD
+	case RatingStateGreat:
+		return resource.Review_GREAT
+	}
+	return resource.Review_RATING_UNSPECIFIED
+}
+
+// toRatingState converts r to RatingState. It returns nil if r is unspecified.
+func toRatingState(r resource.Review_Rating) *RatingState {
+	var s RatingState
+	switch r {
+	case resource.Review_BAD:
+		s = RatingStateBad
+	case resource.Review_AVERAGE:
+		s = RatingStateAverage
+	case resource.Review_GOOD:
+		s = RatingStateGood
+	case resource.Review_GREAT:
+		s = RatingStateGreat
+	default:
+		return nil
+	}
+	return &s
+}
+
+func (s *service) CreateReview(ctx context.Context, workID int32, review *resource.Review) (*resource.Review, error) {
+	if review.Body == "" {
+		return nil, failure.New(errors.InvalidArgument, failure.Message("review body must not be empty"))
+	}
+
+	work, err := s.client.GetWork(ctx, []int64{int64(workID)})
+	if err != nil {
+		return nil, convertError(err)
+	}
+	if work.SearchWorks == nil || len(work.SearchWorks.Edges) == 0 {
+		return nil, failure.New(errors.NotFound, failure.Context{"work_id": workKey(int64(workID))})
+	}
+
+	res, err := s.client.CreateReviewMutation(
+		ctx,
+		work.SearchWorks.Edges[0].Node.ID,
+		review.Body,
+		toRatingState(review.OverallRating),
+		toRatingState(review.AnimationRating),
+		toRatingState(review.MusicRating),
+		toRatingState(review.StoryRating),
+		toRatingState(review.CharacterRating),
+	)
+	if err != nil {
+		return nil, failure.Wrap(convertError(err), failure.Context{"work_id": workKey(int64(workID))})
+	}
+	if res.CreateReview == nil || res.CreateReview.Review == nil {
+		return nil, failure.Unexpected("createReview returned no reviews")
+	}
+
+	r, err := (*reviewNode)(res.CreateReview.Review).resource()
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+	return r, nil
+}
+
+func (s *service) UpdateReview(ctx context.Context, review *resource.Review) (*resource.Review, error) {
+	if review.Id == "" {
+		return nil, failure.New(errors.InvalidArgument, failure.Message("review ID must be specified"))
+	}
+	if review.Body == "" {
+		return nil, failure.New(errors.InvalidArgument, failure.Message("review body must not be empty"))
+	}
+
+	// Annict requires all ratings to update a review.
+	ratings := []resource.Review_Rating{
+		review.OverallRating,
+		review.AnimationRating,
+		review.MusicRating,
+		review.StoryRating,
+		review.CharacterRating,
+	}
+	states := make([]RatingState, len(ratings))
+	for i, r := range ratings {
+		s := toRatingState(r)
+		if s == nil {
+			return nil, failure.New(errors.InvalidArgument, failure.Message("all ratings must be specified"))
+		}
+		states[i] = *s
+	}
+
+	res, err := s.client.UpdateReviewMutation(ctx, review.Id, review.Body, states[0], states[1], states[2], states[3], states[4])
+	if err != nil {
+		return nil, failure.Wrap(convertError(err), failure.Context{"review_id": review.Id})
+	}
+	if res.UpdateReview == nil || res.UpdateReview.Review == nil {
+		return nil, failure.Unexpected("updateReview returned no reviews")
+	}
+
+	r, err := (*reviewNode)(res.UpdateReview.Review).resource()
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+	return r, nil
+}
+
+func (s *service) DeleteReview(ctx context.Context, reviewID string) error {
+	if _, err := s.client.DeleteReviewMutation(ctx, reviewID); err != nil {
+		return failure.Wrap(convertError(err), failure.Context{"review_id": reviewID})
+	}
+	return nil
+}
+
+func (s *service) ListReviews(ctx context.Context, cursor string, limit int32) ([]*resource.Review, string, error) {
+	var after *string
+	if cursor != "" {
+		after = &cursor
+	}
+
+	// Activities include other than reviews, so pages are fetched until limit reviews are collected.
+	var reviews []*resource.Review
+	for page := 0; page < maxReviewPages; page++ {
+		res, err := s.client.ListReviews(ctx, after, reviewActivitiesPageSize)
+		if err != nil {
+			return nil, "", convertError(err)
+		}
+
+		activities := res.Viewer.Activities
+		for i, e := range activities.Edges {
+			if e.Node.Typename != reviewTypename {
+				continue
+			}
+			r, err := (*reviewNode)(&e.Node.Review).resource()
+			if err != nil {
+				return nil, "", failure.Wrap(err)
+			}
+			reviews = append(reviews, r)
+			if len(reviews) == int(limit) {
+				// The next page starts from the activity following the last returned review.
+				if i == len(activities.Edges)-1 && !activities.PageInfo.HasNextPage {
+					return reviews, "", nil
+				}
+				return reviews, e.Cursor, nil
+			}
+		}
+
+		if !activities.PageInfo.HasNextPage || activities.PageInfo.EndCursor == nil {
+			return reviews, "", nil
+		}
+		after = activities.PageInfo.EndCursor
+	}
+
+	// Reviews are fewer than limit within maxReviewPages, so the rest are listed from the next page.
+	ctxzap.Extract(ctx).Warn("reached the max number of review pages", zap.Int("max_review_pages", maxReviewPages))
+	return reviews, *after, nil
+}
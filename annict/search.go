@@ -0,0 +1,67 @@
+package annict
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/GoodCodingFriends/animekai/errors"
+	"github.com/GoodCodingFriends/animekai/resource"
+	"github.com/morikuni/failure"
+)
+
+func (s *service) SearchWorks(ctx context.Context, title string, limit int32) ([]*resource.Work, error) {
+	q := normalizeTitle(title)
+	if q == "" {
+		return nil, failure.New(errors.InvalidArgument, failure.Message("title must not be empty"))
+	}
+
+	res, err := s.client.SearchWorks(ctx, []string{title}, int64(limit))
+	if err != nil {
+		return nil, convertError(err)
+	}
+	if res.SearchWorks == nil {
+		return nil, nil
+	}
+
+	var works, exact []*resource.Work
+	for _, n := range res.SearchWorks.Nodes {
+		w := &resource.Work{
+			Id:            int32(n.AnnictID),
+			Title:         n.Title,
+			EpisodesCount: int32(n.EpisodesCount),
+			Status:        toResourceStatus(n.ViewerStatusState),
+		}
+		setSeason(w, n.SeasonYear, n.SeasonName)
+		works = append(works, w)
+
+		if titleMatches(q, &n.Title, n.TitleKana, n.TitleEn) {
+			exact = append(exact, w)
+		}
+	}
+	if len(exact) != 0 {
+		return exact, nil
+	}
+	return works, nil
+}
+
+// titleMatches reports whether any of titles equals to the normalized title q.
+func titleMatches(q string, titles ...*string) bool {
+	for _, t := range titles {
+		if t != nil && normalizeTitle(*t) == q {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeTitle removes spaces and folds cases so that "Non Non Biyori" matches "non non biyori" and
+// "のんのん びより" matches "のんのんびより".
+func normalizeTitle(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, s)
+}
@@ -0,0 +1,226 @@
+package annict
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GoodCodingFriends/animekai/errors"
+	"github.com/GoodCodingFriends/animekai/resource"
+	"github.com/GoodCodingFriends/animekai/store"
+	"github.com/morikuni/failure"
+)
+
+const (
+	recordSessionsBucket = "record_sessions"
+	latestSessionKey     = "latest"
+
+	defaultRecordSessionWindow = 10 * time.Minute
+)
+
+// RecordOption configures CreateNextEpisodeRecords.
+type RecordOption func(*recordOptions)
+
+type recordOptions struct {
+	dryRun    bool
+	sessionID string
+	episodes  int
+	filter    workFilter
+	comment   string
+	ratings   []workRating
+}
+
+type workRating struct {
+	pattern string
+	state   RatingState
+}
+
+// reaction returns the comment and the rating attached to the record of the work.
+// They are nil if they are not specified.
+func (o *recordOptions) reaction(workID int64, title string) (comment *string, rating *RatingState) {
+	if o.comment != "" {
+		c := o.comment
+		comment = &c
+	}
+	for _, r := range o.ratings {
+		if matchWork([]string{r.pattern}, workID, title) {
+			state := r.state
+			rating = &state
+		}
+	}
+	return comment, rating
+}
+
+// DryRun makes CreateNextEpisodeRecords return the episodes which would be recorded without creating any records.
+func DryRun() RecordOption {
+	return func(o *recordOptions) {
+		o.dryRun = true
+	}
+}
+
+// WithSessionID specifies the ID of the viewing session.
+// CreateNextEpisodeRecords doesn't record works which are already recorded in the session and returns the episodes
+// recorded in the session for them instead. Without a session ID, calls within the record session window are
+// regarded as the same session.
+func WithSessionID(id string) RecordOption {
+	return func(o *recordOptions) {
+		o.sessionID = id
+	}
+}
+
+// WithEpisodes makes CreateNextEpisodeRecords record at most n episodes per work in order.
+// If the last episode of a work is recorded, the work is marked as WATCHED.
+func WithEpisodes(n int) RecordOption {
+	return func(o *recordOptions) {
+		o.episodes = n
+	}
+}
+
+// WithComment attaches comment to the records created by CreateNextEpisodeRecords.
+// If several episodes of a work are recorded, the comment is attached to the last one.
+func WithComment(comment string) RecordOption {
+	return func(o *recordOptions) {
+		o.comment = comment
+	}
+}
+
+// WithRating rates the episodes of works matched with pattern. The pattern is interpreted in the same way as
+// IncludeWorks. If a work is matched with several patterns, the last one is used.
+// If several episodes of a work are recorded, the rating is attached to the last one.
+func WithRating(pattern string, rating RatingState) RecordOption {
+	return func(o *recordOptions) {
+		o.ratings = append(o.ratings, workRating{pattern: pattern, state: rating})
+	}
+}
+
+// IncludeWorks limits works to be recorded to works matched with patterns.
+// A pattern matches a work if it is the Annict work ID of the work or a substring of the work title.
+func IncludeWorks(patterns ...string) RecordOption {
+	return func(o *recordOptions) {
+		o.filter.include = append(o.filter.include, patterns...)
+	}
+}
+
+// ExcludeWorks excludes works matched with patterns from works to be recorded.
+// Patterns are interpreted in the same way as IncludeWorks.
+func ExcludeWorks(patterns ...string) RecordOption {
+	return func(o *recordOptions) {
+		o.filter.exclude = append(o.filter.exclude, patterns...)
+	}
+}
+
+type workFilter struct {
+	include, exclude []string
+}
+
+// match reports whether the work is selected by the filter.
+func (f *workFilter) match(workID int64, title string) bool {
+	if len(f.include) != 0 && !matchWork(f.include, workID, title) {
+		return false
+	}
+	return !matchWork(f.exclude, workID, title)
+}
+
+func matchWork(patterns []string, workID int64, title string) bool {
+	for _, p := range patterns {
+		if id, err := strconv.ParseInt(p, 10, 64); err == nil {
+			if id == workID {
+				return true
+			}
+			continue
+		}
+		if p != "" && strings.Contains(title, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// recordSession is a set of records created by CreateNextEpisodeRecords calls in a viewing session.
+type recordSession struct {
+	ID        string              `json:"id"`
+	CreatedAt time.Time           `json:"created_at"`
+	Episodes  []*resource.Episode `json:"episodes"`
+	// RecordIDs are IDs of the created records.
+	RecordIDs []string `json:"record_ids"`
+	// FinishedWorkIDs are IDs of works which are marked as WATCHED in the session.
+	FinishedWorkIDs []string `json:"finished_work_ids"`
+}
+
+func newRecordSession(id string, now time.Time) *recordSession {
+	if id == "" {
+		id = strconv.FormatInt(now.UnixNano(), 10)
+	}
+	return &recordSession{ID: id, CreatedAt: now}
+}
+
+// recorded returns the episodes recorded in the session for the work identified by workID.
+// It returns nil if the work is not recorded in the session.
+func (s *recordSession) recorded(workID int32) []*resource.Episode {
+	var episodes []*resource.Episode
+	for _, e := range s.Episodes {
+		if e.WorkID == workID {
+			episodes = append(episodes, e)
+		}
+	}
+	return episodes
+}
+
+// empty reports whether nothing is recorded in the session.
+func (s *recordSession) empty() bool {
+	return len(s.Episodes) == 0 && len(s.RecordIDs) == 0 && len(s.FinishedWorkIDs) == 0
+}
+
+// includes reports whether a call with sessionID at now belongs to the session.
+func (s *recordSession) includes(sessionID string, now time.Time, window time.Duration) bool {
+	if sessionID != "" {
+		return s.ID == sessionID
+	}
+	return now.Sub(s.CreatedAt) < window
+}
+
+// sessionJournal persists the latest record session.
+type sessionJournal struct {
+	store store.Store
+}
+
+func newSessionJournal(s store.Store) *sessionJournal {
+	return &sessionJournal{store: s}
+}
+
+// latest returns the latest record session. It returns nil if there are no sessions.
+func (j *sessionJournal) latest() (*recordSession, error) {
+	b, err := j.store.Get(recordSessionsBucket, latestSessionKey)
+	if failure.Is(err, errors.NotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+
+	var s recordSession
+	if err := json.Unmarshal(b, &s); err != nil {
+		return nil, failure.Translate(err, errors.Internal)
+	}
+	return &s, nil
+}
+
+func (j *sessionJournal) save(s *recordSession) error {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return failure.Translate(err, errors.Internal)
+	}
+	if err := j.store.Put(recordSessionsBucket, latestSessionKey, b); err != nil {
+		return failure.Wrap(err)
+	}
+	return nil
+}
+
+// clear removes the latest record session.
+func (j *sessionJournal) clear() error {
+	if err := j.store.Delete(recordSessionsBucket, latestSessionKey); err != nil {
+		return failure.Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package annict
+
+import (
+	"context"
+
+	"github.com/GoodCodingFriends/animekai/resource"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"go.uber.org/zap"
+)
+
+const (
+	staffWorksPageSize = 20
+	// maxStaffPages limits pages of works because each page contains staffs of all works in it.
+	maxStaffPages = 25
+)
+
+// staffRoles maps role texts of Annict to roles. Staffs having other roles are ignored.
+var staffRoles = map[string]resource.Staff_Role{
+	"アニメーション制作": resource.Staff_STUDIO,
+	"監督":        resource.Staff_DIRECTOR,
+	"総監督":       resource.Staff_DIRECTOR,
+	"音楽":        resource.Staff_COMPOSER,
+	"シリーズ構成":    resource.Staff_SERIES_COMPOSITION,
+}
+
+func (s *service) ListWorkStaffs(ctx context.Context, state StatusState) ([]*resource.Staff, error) {
+	var (
+		stateP *StatusState
+		after  *string
+		staffs []*resource.Staff
+	)
+	if state != StatusStateNoState {
+		stateP = &state
+	}
+
+	for page := 0; ; page++ {
+		if page == maxStaffPages {
+			ctxzap.Extract(ctx).Warn("reached the max number of staff pages", zap.Int("max_staff_pages", maxStaffPages))
+			break
+		}
+
+		res, err := s.client.ListWorkStaffs(ctx, stateP, after, staffWorksPageSize)
+		if err != nil {
+			return nil, convertError(err)
+		}
+
+		for _, e := range res.Viewer.Works.Edges {
+			w := e.Node
+			if w.Staffs == nil {
+				continue
+			}
+			for _, n := range w.Staffs.Nodes {
+				role, ok := staffRoles[n.RoleText]
+				if !ok {
+					continue
+				}
+				st := &resource.Staff{
+					Role:      role,
+					WorkId:    int32(w.AnnictID),
+					WorkTitle: w.Title,
+				}
+				switch n.Resource.Typename {
+				case "Organization":
+					st.Id, st.Name = int32(n.Resource.Organization.AnnictID), n.Resource.Organization.Name
+					st.Type = resource.Staff_ORGANIZATION
+				case "Person":
+					st.Id, st.Name = int32(n.Resource.Person.AnnictID), n.Resource.Person.Name
+					st.Type = resource.Staff_PERSON
+				default:
+					continue
+				}
+				staffs = append(staffs, st)
+			}
+		}
+
+		pageInfo := res.Viewer.Works.PageInfo
+		if !pageInfo.HasNextPage || pageInfo.EndCursor == nil {
+			break
+		}
+		after = pageInfo.EndCursor
+	}
+	return staffs, nil
+}
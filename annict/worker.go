@@ -21,27 +21,40 @@ const maxWorkers = 10
 type ogImageFetcher struct {
 	sem    *semaphore.Weighted
 	client *http.Client
+	cache  *imageCache
 }
 
-func newOGImageFetcher() *ogImageFetcher {
+func newOGImageFetcher(cache *imageCache) *ogImageFetcher {
 	return &ogImageFetcher{
 		sem:    semaphore.NewWeighted(maxWorkers),
 		client: http.DefaultClient,
+		cache:  cache,
 	}
 }
 
 func (f *ogImageFetcher) process(ctx context.Context, workID int32) (<-chan string, error) {
+	ch := make(chan string, 1)
+	if entry, ok := f.cache.get(workID); ok {
+		ch <- entry.URL
+		return ch, nil
+	}
+
 	if err := f.sem.Acquire(ctx, 1); err != nil {
 		return nil, failure.Translate(err, errors.Internal)
 	}
 
-	ch := make(chan string, 1)
 	go func() {
 		defer f.sem.Release(1)
 
-		url, err := f.run(ctx, workID)
-		if err != nil {
-			ctxzap.Extract(ctx).Warn("failed to fetch OGP", zap.Error(err))
+		url, fetchErr := f.run(ctx, workID)
+		if fetchErr != nil {
+			ctxzap.Extract(ctx).Warn("failed to fetch OGP", zap.Error(fetchErr))
+		}
+		// Don't cache the result if the caller canceled the request.
+		if ctx.Err() == nil {
+			if err := f.cache.set(workID, url, fetchErr); err != nil {
+				ctxzap.Extract(ctx).Warn("failed to cache OGP", zap.Error(err))
+			}
 		}
 		select {
 		case <-ctx.Done():
@@ -54,6 +67,20 @@ func (f *ogImageFetcher) process(ctx context.Context, workID int32) (<-chan stri
 	return ch, nil
 }
 
+// resolve implements imageResolver.
+func (f *ogImageFetcher) resolve(ctx context.Context, img *workImage) (string, error) {
+	doneCh, err := f.process(ctx, img.workID)
+	if err != nil {
+		return "", failure.Wrap(err)
+	}
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case url := <-doneCh:
+		return url, nil
+	}
+}
+
 func (f *ogImageFetcher) run(ctx context.Context, workID int32) (string, error) {
 	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
 	defer cancel()
@@ -7,15 +7,18 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
 	"time"
 
 	"github.com/GoodCodingFriends/animekai/annict"
 	"github.com/GoodCodingFriends/animekai/config"
 	"github.com/GoodCodingFriends/animekai/errors"
+	"github.com/GoodCodingFriends/animekai/imageproxy"
 	"github.com/GoodCodingFriends/animekai/server"
 	"github.com/GoodCodingFriends/animekai/slack"
 	"github.com/GoodCodingFriends/animekai/statistics"
+	"github.com/GoodCodingFriends/animekai/store"
 	"github.com/GoodCodingFriends/animekai/testutil"
 	"github.com/kelseyhightower/envconfig"
 	"github.com/mitchellh/go-testing-interface"
@@ -38,6 +41,16 @@ func realMain() error { //nolint:funlen
 	if err := envconfig.Process("", &cfg); err != nil {
 		return failure.Translate(err, errors.Internal)
 	}
+	if cfg.AnnictMaxRecordPages < 1 {
+		return failure.New(errors.InvalidArgument, failure.Message("ANNICT_MAX_RECORD_PAGES must be greater than 0"))
+	}
+	// Reminded programs must survive restarts, otherwise the same reminders are posted again after a restart.
+	if cfg.ReminderSchedule != "" && cfg.StorePath == "" {
+		return failure.New(
+			errors.InvalidArgument,
+			failure.Message("REMINDER_SCHEDULE requires STORE_PATH on a persistent volume"),
+		)
+	}
 
 	logger, err := zap.NewDevelopment()
 	if err != nil {
@@ -63,7 +76,32 @@ func realMain() error { //nolint:funlen
 		statikFS = fs
 	}
 
-	annictService := annict.New(cfg.AnnictToken, cfg.AnnictEndpoint)
+	st := store.NewMemory()
+	if cfg.StorePath != "" {
+		bolt, err := store.NewBolt(cfg.StorePath)
+		if err != nil {
+			return failure.Wrap(err)
+		}
+		st = bolt
+		logger.Info("persistent store is enabled", zap.String("path", cfg.StorePath))
+	}
+	defer func() {
+		if err := st.Close(); err != nil {
+			logger.Error("failed to close store", zap.Error(err))
+		}
+	}()
+
+	annictService := annict.New(
+		cfg.AnnictToken,
+		cfg.AnnictEndpoint,
+		annict.WithMaxRecordPages(cfg.AnnictMaxRecordPages),
+		annict.WithRecordSyncInterval(cfg.RecordSyncInterval),
+		annict.WithStore(st),
+		annict.WithImageCacheTTL(cfg.ImageCacheTTL),
+		annict.WithPlaceholderImageURL(cfg.PlaceholderImageURL),
+		annict.WithImageProxyURL(cfg.ImageProxyURL),
+		annict.WithRecordSessionWindow(cfg.RecordSessionWindow),
+	)
 	defer func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
 		defer cancel()
@@ -72,12 +110,41 @@ func realMain() error { //nolint:funlen
 		}
 	}()
 
-	slackService := slack.NewCommandHandler(logger, cfg.SlackSigningSecret, cfg.SlackWebhookURL, annictService)
+	return serve(logger, &cfg, st, annictService, statikFS)
+}
+
+// serve starts the scheduler and serves the API until the server is shut down.
+func serve(
+	logger *zap.Logger, cfg *config.Config, st store.Store, annictService annict.Service, statikFS http.FileSystem,
+) error {
+	slackService := slack.NewCommandHandler(logger, cfg.SlackSigningSecret, cfg.SlackWebhookURL, cfg.SlackBotToken, annictService)
+	slackInteractionHandler := slack.NewInteractionHandler(logger, cfg.SlackSigningSecret, cfg.SlackWebhookURL, cfg.SlackBotToken, annictService)
+
+	scheduler, err := newScheduler(
+		logger.Named("scheduler"),
+		cfg,
+		slack.NewDigest(cfg.SlackWebhookURL, annictService),
+		slack.NewReminder(cfg.SlackWebhookURL, cfg.ReminderLeadTime, st, annictService),
+	)
+	if err != nil {
+		return failure.Wrap(err)
+	}
+	scheduler.Start()
+	defer func() {
+		<-scheduler.Stop().Done()
+	}()
+
+	imageHandler, err := newImageHandler(logger, cfg, annictService)
+	if err != nil {
+		return failure.Wrap(err)
+	}
 
 	handler := server.New(
 		logger,
 		statistics.New(annictService),
 		slackService,
+		slackInteractionHandler,
+		imageHandler,
 		statikFS,
 		!cfg.Env.IsProd(),
 	)
@@ -107,3 +174,16 @@ func realMain() error { //nolint:funlen
 	logger.Info("server listen in :" + cfg.Port)
 	return srv.ListenAndServe()
 }
+
+// newImageHandler returns the image proxy caching images in IMAGE_CACHE_DIR or the temporary directory.
+func newImageHandler(logger *zap.Logger, cfg *config.Config, annictService annict.Service) (http.Handler, error) {
+	dir := cfg.ImageCacheDir
+	if dir == "" {
+		dir = filepath.Join(os.TempDir(), "animekai", "images")
+	}
+	h, err := imageproxy.NewHandler(logger.Named("imageproxy"), dir, cfg.ImageCacheTTL, annictService)
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+	return h, nil
+}
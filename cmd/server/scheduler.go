@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/GoodCodingFriends/animekai/annict"
+	"github.com/GoodCodingFriends/animekai/config"
+	"github.com/GoodCodingFriends/animekai/errors"
+	"github.com/GoodCodingFriends/animekai/slack"
+	"github.com/morikuni/failure"
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+)
+
+const jobTimeout = 1 * time.Minute
+
+// newScheduler returns a scheduler which runs periodic jobs. Schedules are cron expressions evaluated in JST,
+// and jobs whose schedules are empty are disabled.
+func newScheduler(logger *zap.Logger, cfg *config.Config, digest slack.Digest, reminder slack.Reminder) (*cron.Cron, error) {
+	if err := validateReminderSchedule(cfg.ReminderSchedule, cfg.ReminderLeadTime); err != nil {
+		return nil, failure.Wrap(err)
+	}
+
+	c := cron.New(cron.WithLocation(annict.JST))
+
+	err := addJob(c, logger, "digest", cfg.DigestSchedule, func(ctx context.Context) error {
+		until := time.Now().In(annict.JST)
+		return digest.Post(ctx, until.Add(-cfg.DigestPeriod), until)
+	})
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+
+	// Overlapping runs are skipped so that a slow run doesn't post the same reminders twice.
+	err = addJob(c, logger, "reminder", cfg.ReminderSchedule, reminder.Remind, cron.SkipIfStillRunning(cron.DiscardLogger))
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+
+	return c, nil
+}
+
+// validateReminderSchedule validates that the reminder runs at least once every leadTime. Each run reminds programs
+// starting within leadTime, so programs starting between runs would be missed otherwise.
+func validateReminderSchedule(schedule string, leadTime time.Duration) error {
+	if schedule == "" {
+		return nil
+	}
+	sched, err := cron.ParseStandard(schedule)
+	if err != nil {
+		return failure.Translate(err, errors.InvalidArgument, failure.Context{"job": "reminder", "schedule": schedule})
+	}
+	if d := maxScheduleInterval(sched, time.Now().In(annict.JST)); d > leadTime {
+		return failure.New(
+			errors.InvalidArgument,
+			failure.Context{"schedule": schedule, "lead_time": leadTime.String(), "interval": d.String()},
+			failure.Message("REMINDER_SCHEDULE must run at least once every REMINDER_LEAD_TIME"),
+		)
+	}
+	return nil
+}
+
+// maxScheduleInterval returns the longest interval between runs of sched in a week from from.
+func maxScheduleInterval(sched cron.Schedule, from time.Time) time.Duration {
+	var longest time.Duration
+	t := sched.Next(from)
+	for end := t.Add(7 * 24 * time.Hour); !t.IsZero() && t.Before(end); {
+		next := sched.Next(t)
+		if next.IsZero() {
+			break
+		}
+		if d := next.Sub(t); d > longest {
+			longest = d
+		}
+		t = next
+	}
+	return longest
+}
+
+// addJob registers fn to c with schedule. If schedule is empty, addJob does nothing.
+func addJob(
+	c *cron.Cron,
+	logger *zap.Logger,
+	name, schedule string,
+	fn func(ctx context.Context) error,
+	wrappers ...cron.JobWrapper,
+) error {
+	if schedule == "" {
+		return nil
+	}
+
+	job := cron.FuncJob(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), jobTimeout)
+		defer cancel()
+
+		if err := fn(ctx); err != nil {
+			logger.Error("failed to run job", zap.String("job", name), zap.Error(err))
+		}
+	})
+	if _, err := c.AddJob(schedule, cron.NewChain(wrappers...).Then(job)); err != nil {
+		return failure.Translate(err, errors.InvalidArgument, failure.Context{"job": name, "schedule": schedule})
+	}
+	logger.Info("job is enabled", zap.String("job", name), zap.String("schedule", schedule))
+	return nil
+}
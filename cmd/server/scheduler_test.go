@@ -0,0 +1,38 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/GoodCodingFriends/animekai/errors"
+	"github.com/morikuni/failure"
+)
+
+func TestValidateReminderSchedule(t *testing.T) {
+	cases := map[string]struct {
+		schedule string
+		wantErr  bool
+	}{
+		"disabled":         {schedule: ""},
+		"every 5 minutes":  {schedule: "*/5 * * * *"},
+		"every 15 minutes": {schedule: "*/15 * * * *"},
+		"every 30 minutes": {schedule: "*/30 * * * *", wantErr: true},
+		"only daytime":     {schedule: "*/5 9-23 * * *", wantErr: true},
+		"invalid":          {schedule: "every day", wantErr: true},
+	}
+	for name, c := range cases {
+		c := c
+		t.Run(name, func(t *testing.T) {
+			err := validateReminderSchedule(c.schedule, 15*time.Minute)
+			if c.wantErr {
+				if !failure.Is(err, errors.InvalidArgument) {
+					t.Errorf("expected InvalidArgument, but got '%v'", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("should not return an error, but got '%s'", err)
+			}
+		})
+	}
+}
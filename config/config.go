@@ -1,12 +1,32 @@
 package config
 
+import "time"
+
+// Config is loaded from environment variables.
+// STORE_PATH must be on a persistent volume because local disks of some platforms such as Cloud Run are wiped on
+// restarts. It is required if REMINDER_SCHEDULE is specified so that reminders are not posted again after restarts.
+// REMINDER_SCHEDULE must run at least once every REMINDER_LEAD_TIME, otherwise programs starting between runs are
+// not reminded.
 type Config struct {
-	Port               string `envconfig:"PORT" default:"8000"`
-	Env                Env    `envconfig:"ENV" default:"dev"`
-	AnnictToken        string `envconfig:"ANNICT_TOKEN" required:"true"`
-	AnnictEndpoint     string `envconfig:"ANNICT_ENDPOINT" required:"true"`
-	SlackSigningSecret string `envconfig:"SLACK_SIGNING_SECRET" required:"true"`
-	SlackWebhookURL    string `envconfig:"SLACK_WEBHOOK_URL" required:"true"`
+	Port                 string        `envconfig:"PORT" default:"8000"`
+	Env                  Env           `envconfig:"ENV" default:"dev"`
+	AnnictToken          string        `envconfig:"ANNICT_TOKEN" required:"true"`
+	AnnictEndpoint       string        `envconfig:"ANNICT_ENDPOINT" required:"true"`
+	AnnictMaxRecordPages int           `envconfig:"ANNICT_MAX_RECORD_PAGES" default:"100"`
+	RecordSyncInterval   time.Duration `envconfig:"RECORD_SYNC_INTERVAL" default:"1m"`
+	SlackSigningSecret   string        `envconfig:"SLACK_SIGNING_SECRET" required:"true"`
+	SlackWebhookURL      string        `envconfig:"SLACK_WEBHOOK_URL" required:"true"`
+	SlackBotToken        string        `envconfig:"SLACK_BOT_TOKEN"`
+	StorePath            string        `envconfig:"STORE_PATH"`
+	ImageCacheTTL        time.Duration `envconfig:"IMAGE_CACHE_TTL" default:"24h"`
+	PlaceholderImageURL  string        `envconfig:"PLACEHOLDER_IMAGE_URL"`
+	ImageProxyURL        string        `envconfig:"IMAGE_PROXY_URL" default:"/images"`
+	ImageCacheDir        string        `envconfig:"IMAGE_CACHE_DIR"`
+	RecordSessionWindow  time.Duration `envconfig:"RECORD_SESSION_WINDOW" default:"10m"`
+	DigestSchedule       string        `envconfig:"DIGEST_SCHEDULE"`
+	DigestPeriod         time.Duration `envconfig:"DIGEST_PERIOD" default:"168h"`
+	ReminderSchedule     string        `envconfig:"REMINDER_SCHEDULE"`
+	ReminderLeadTime     time.Duration `envconfig:"REMINDER_LEAD_TIME" default:"15m"`
 }
 
 type Env string
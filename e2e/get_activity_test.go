@@ -0,0 +1,42 @@
+package e2e_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/GoodCodingFriends/animekai/api"
+	"github.com/GoodCodingFriends/animekai/resource"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetActivity(t *testing.T) {
+	client := newClientAndRunServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	res, err := client.GetActivity(ctx, &api.GetActivityRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	format := func(counts []*resource.Activity_Count) []string {
+		var s []string
+		for _, c := range counts {
+			s = append(s, fmt.Sprintf("%s: %d", c.Date, c.EpisodesCount))
+		}
+		return s
+	}
+	// Records are counted by dates in JST.
+	if diff := cmp.Diff([]string{"2020-05-20: 2", "2020-05-23: 1", "2020-05-24: 8"}, format(res.Activity.Daily[:3])); diff != "" {
+		t.Errorf("daily: -want, +got\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"2020-05-18: 11", "2020-05-25: 3"}, format(res.Activity.Weekly[:2])); diff != "" {
+		t.Errorf("weekly: -want, +got\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"2020-05-01: 14", "2020-06-01: 16", "2020-07-01: 8"}, format(res.Activity.Monthly)); diff != "" {
+		t.Errorf("monthly: -want, +got\n%s", diff)
+	}
+}
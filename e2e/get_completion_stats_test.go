@@ -0,0 +1,40 @@
+package e2e_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GoodCodingFriends/animekai/api"
+)
+
+func TestGetCompletionStats(t *testing.T) {
+	client := newClientAndRunServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	res, err := client.GetCompletionStats(ctx, &api.GetCompletionStatsRequest{Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stats := res.Stats
+	if expected := 1; expected != len(stats.FinishedWorks) {
+		t.Fatalf("expected number of finished works is %d, but got %d", expected, len(stats.FinishedWorks))
+	}
+	w := stats.LongestWork
+	if expected := "結城友奈は勇者である"; expected != w.Title {
+		t.Errorf("expected title is %s, but got %s", expected, w.Title)
+	}
+	// From 2020-05-20 to 2020-07-04 in JST.
+	if expected := int32(46); expected != w.Days {
+		t.Errorf("expected days are %d, but got %d", expected, w.Days)
+	}
+	if expected := int32(12); expected != w.EpisodesCount {
+		t.Errorf("expected number of episodes is %d, but got %d", expected, w.EpisodesCount)
+	}
+	if expected := 46.0; expected != stats.MedianDays {
+		t.Errorf("expected median days are %f, but got %f", expected, stats.MedianDays)
+	}
+}
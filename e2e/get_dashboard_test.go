@@ -27,4 +27,8 @@ func TestGetDashboard(t *testing.T) {
 	if res.WorkNextPageToken == "" {
 		t.Errorf("NextPageToken should not be empty")
 	}
+	// Records are created every week from 2020-05-18 to 2020-06-14 in JST.
+	if expected := int32(4); expected != res.Dashboard.Streak.Longest {
+		t.Errorf("expected longest streak is %d, but got %d", expected, res.Dashboard.Streak.Longest)
+	}
 }
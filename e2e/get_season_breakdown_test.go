@@ -0,0 +1,40 @@
+package e2e_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/GoodCodingFriends/animekai/api"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetSeasonBreakdown(t *testing.T) {
+	client := newClientAndRunServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	res, err := client.GetSeasonBreakdown(ctx, &api.GetSeasonBreakdownRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var seasons []string
+	for _, s := range res.Breakdown.Seasons {
+		// The dummy server returns the same works for each state, so every work is counted three times.
+		seasons = append(seasons, fmt.Sprintf(
+			"%d %s: %d works, %d episodes",
+			s.Year, s.Season, s.WatchingCount+s.WatchedCount+s.DroppedCount, s.EpisodesCount,
+		))
+	}
+	expected := []string{
+		"2019 SPRING: 3 works, 0 episodes",
+		"2019 SUMMER: 6 works, 36 episodes",
+		"2019 AUTUMN: 6 works, 108 episodes",
+	}
+	if diff := cmp.Diff(expected, seasons); diff != "" {
+		t.Errorf("-want, +got\n%s", diff)
+	}
+}
@@ -0,0 +1,47 @@
+package e2e_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/GoodCodingFriends/animekai/api"
+	"github.com/GoodCodingFriends/animekai/resource"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetStaffStats(t *testing.T) {
+	client := newClientAndRunServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	res, err := client.GetStaffStats(ctx, &api.GetStaffStatsRequest{Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	format := func(entries []*resource.StaffStats_Entry) []string {
+		var s []string
+		for _, e := range entries {
+			s = append(s, fmt.Sprintf("%s (%s): %d works", e.Name, e.Type, e.WorksCount))
+		}
+		return s
+	}
+	actual := map[string][]string{
+		"studios":                    format(res.Stats.Studios),
+		"directors":                  format(res.Stats.Directors),
+		"composers":                  format(res.Stats.Composers),
+		"series_composition_writers": format(res.Stats.SeriesCompositionWriters),
+	}
+	expected := map[string][]string{
+		"studios":                    {"SILVER LINK. (ORGANIZATION): 2 works"},
+		"directors":                  {"川面真也 (PERSON): 2 works"},
+		"composers":                  {"水谷広実 (PERSON): 1 works"},
+		"series_composition_writers": {"吉田玲子 (PERSON): 1 works", "川面真也 (PERSON): 1 works"},
+	}
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("-want, +got\n%s", diff)
+	}
+}
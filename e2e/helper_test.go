@@ -43,6 +43,8 @@ func newClientAndRunServer(t *testing.T) *client {
 		statistics.New(annict.New(cfg.AnnictToken, cfg.AnnictEndpoint)),
 		http.HandlerFunc(nil),
 		nil,
+		nil,
+		nil,
 		false,
 	)
 	srv := &http.Server{Addr: "127.0.0.1:8000", Handler: handler}
@@ -93,6 +95,54 @@ func (c *client) ListWorks(ctx context.Context, req *api.ListWorksRequest) (*api
 	return &m, nil
 }
 
+func (c *client) ListReviews(ctx context.Context, req *api.ListReviewsRequest) (*api.ListReviewsResponse, error) {
+	res := c.post(c.endpoint("listreviews"), req) //nolint:bodyclose
+
+	var m api.ListReviewsResponse
+	c.unmarshal(res.Body, &m)
+	return &m, nil
+}
+
+func (c *client) ListUpcomingPrograms(ctx context.Context, req *api.ListUpcomingProgramsRequest) (*api.ListUpcomingProgramsResponse, error) {
+	res := c.post(c.endpoint("listupcomingprograms"), req) //nolint:bodyclose
+
+	var m api.ListUpcomingProgramsResponse
+	c.unmarshal(res.Body, &m)
+	return &m, nil
+}
+
+func (c *client) GetActivity(ctx context.Context, req *api.GetActivityRequest) (*api.GetActivityResponse, error) {
+	res := c.post(c.endpoint("getactivity"), req) //nolint:bodyclose
+
+	var m api.GetActivityResponse
+	c.unmarshal(res.Body, &m)
+	return &m, nil
+}
+
+func (c *client) GetCompletionStats(ctx context.Context, req *api.GetCompletionStatsRequest) (*api.GetCompletionStatsResponse, error) {
+	res := c.post(c.endpoint("getcompletionstats"), req) //nolint:bodyclose
+
+	var m api.GetCompletionStatsResponse
+	c.unmarshal(res.Body, &m)
+	return &m, nil
+}
+
+func (c *client) GetSeasonBreakdown(ctx context.Context, req *api.GetSeasonBreakdownRequest) (*api.GetSeasonBreakdownResponse, error) {
+	res := c.post(c.endpoint("getseasonbreakdown"), req) //nolint:bodyclose
+
+	var m api.GetSeasonBreakdownResponse
+	c.unmarshal(res.Body, &m)
+	return &m, nil
+}
+
+func (c *client) GetStaffStats(ctx context.Context, req *api.GetStaffStatsRequest) (*api.GetStaffStatsResponse, error) {
+	res := c.post(c.endpoint("getstaffstats"), req) //nolint:bodyclose
+
+	var m api.GetStaffStatsResponse
+	c.unmarshal(res.Body, &m)
+	return &m, nil
+}
+
 func (c *client) post(url string, req proto.Message) *http.Response {
 	b, err := protojson.Marshal(req)
 	if err != nil {
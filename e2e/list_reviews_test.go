@@ -0,0 +1,34 @@
+package e2e_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GoodCodingFriends/animekai/api"
+	"github.com/GoodCodingFriends/animekai/resource"
+)
+
+func TestListReviews(t *testing.T) {
+	client := newClientAndRunServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	res, err := client.ListReviews(ctx, &api.ListReviewsRequest{PageSize: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := 2; expected != len(res.Reviews) {
+		t.Fatalf("expected number of reviews is %d, but got %d", expected, len(res.Reviews))
+	}
+	if res.NextPageToken != "" {
+		t.Errorf("NextPageToken should be empty, but got %s", res.NextPageToken)
+	}
+	if expected := "結城友奈は勇者である"; expected != res.Reviews[0].WorkTitle {
+		t.Errorf("expected title is %s, but got %s", expected, res.Reviews[0].WorkTitle)
+	}
+	if expected := resource.Review_GREAT; expected != res.Reviews[0].OverallRating {
+		t.Errorf("expected overall rating is %s, but got %s", expected, res.Reviews[0].OverallRating)
+	}
+}
@@ -0,0 +1,25 @@
+package e2e_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GoodCodingFriends/animekai/api"
+)
+
+func TestListUpcomingPrograms(t *testing.T) {
+	client := newClientAndRunServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// The dummy server returns a program in the far future and a program which is already broadcast.
+	res, err := client.ListUpcomingPrograms(ctx, &api.ListUpcomingProgramsRequest{Hours: 24})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Programs) != 0 {
+		t.Errorf("no programs should be listed, but got %v", res.Programs)
+	}
+}
@@ -7,5 +7,6 @@ const (
 	DeadlineExceeded failure.StringCode = "DeadlineExceeded"
 	InvalidArgument  failure.StringCode = "InvalidArgument"
 	Internal         failure.StringCode = "Internal"
+	NotFound         failure.StringCode = "NotFound"
 	Unauthenticated  failure.StringCode = "Unauthenticated"
 )
@@ -19,10 +19,13 @@ require (
 	github.com/nametake/protoc-gen-gohttp v1.2.0
 	github.com/nlopes/slack v0.6.0
 	github.com/rakyll/statik v0.1.7
+	github.com/robfig/cron/v3 v3.0.1
 	github.com/rs/cors v1.7.0
 	github.com/slack-go/slack v0.6.4
 	github.com/yhat/scrape v0.0.0-20161128144610-24b7890b0945
+	go.etcd.io/bbolt v1.3.5
 	go.uber.org/zap v1.15.0
+	golang.org/x/image v0.0.0-20200618115811-c13761719519
 	golang.org/x/net v0.0.0-20200625001655-4c5254603344
 	golang.org/x/sync v0.0.0-20200625203802-6e8e738ad208
 	golang.org/x/tools v0.0.0-20200717024301-6ddee64345a6 // indirect
@@ -0,0 +1,256 @@
+package imageproxy
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1" //nolint:gosec
+	"encoding/hex"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/GoodCodingFriends/animekai/annict"
+	"github.com/GoodCodingFriends/animekai/errors"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/morikuni/failure"
+	"go.uber.org/zap"
+	"golang.org/x/image/draw"
+	"golang.org/x/sync/singleflight"
+
+	// Register decoders for image formats used by Annict.
+	_ "image/gif"
+	_ "image/png"
+
+	_ "golang.org/x/image/webp"
+)
+
+// sizes maps thumbnail size names to their widths.
+var sizes = map[string]int{
+	"small":  160,
+	"medium": 320,
+	"large":  640,
+}
+
+const (
+	defaultSize = "medium"
+
+	maxImageBytes = 10 << 20
+	// maxImagePixels limits the size of decoded images because small files can be decoded into huge images.
+	maxImagePixels = 4096 * 4096
+	fetchTimeout   = 5 * time.Second
+)
+
+type handler struct {
+	logger *zap.Logger
+	client *http.Client
+	dir    string
+	ttl    time.Duration
+
+	annict annict.Service
+
+	group singleflight.Group
+}
+
+// NewHandler returns a handler which serves thumbnails of work images at /images/<workID>?size=<size>.
+// size is one of small, medium or large. Thumbnails are cached in dir for ttl.
+func NewHandler(logger *zap.Logger, dir string, ttl time.Duration, annictService annict.Service) (http.Handler, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, failure.Translate(err, errors.Internal, failure.Context{"dir": dir})
+	}
+	return &handler{
+		logger: logger,
+		client: http.DefaultClient,
+		dir:    dir,
+		ttl:    ttl,
+		annict: annictService,
+	}, nil
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	workID, err := strconv.ParseInt(path.Base(r.URL.Path), 10, 32)
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	size := r.URL.Query().Get("size")
+	if size == "" {
+		size = defaultSize
+	}
+	width, ok := sizes[size]
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	logger := h.logger.With(zap.Int64("work_id", workID), zap.String("size", size))
+	ctx := ctxzap.ToContext(r.Context(), logger)
+
+	b, modTime, err := h.thumbnail(ctx, int32(workID), size, width)
+	if err != nil {
+		if failure.Is(err, errors.NotFound) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		logger.Error("failed to get thumbnail", zap.Error(err))
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	sum := sha1.Sum(b) //nolint:gosec
+	w.Header().Set("Content-Type", "image/jpeg")
+	w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:])+`"`)
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(h.ttl.Seconds())))
+	// ServeContent handles If-None-Match and If-Modified-Since.
+	http.ServeContent(w, r, "", modTime, bytes.NewReader(b))
+}
+
+// thumbnail returns the cached thumbnail. If it is not cached or expired, thumbnail fetches the original image and
+// caches a resized one.
+func (h *handler) thumbnail(ctx context.Context, workID int32, size string, width int) ([]byte, time.Time, error) {
+	fname := filepath.Join(h.dir, fmt.Sprintf("%d_%s.jpg", workID, size))
+	if fi, err := os.Stat(fname); err == nil && time.Since(fi.ModTime()) < h.ttl {
+		b, err := ioutil.ReadFile(fname)
+		if err == nil {
+			return b, fi.ModTime(), nil
+		}
+		ctxzap.Extract(ctx).Warn("failed to read cached thumbnail", zap.Error(err))
+	}
+
+	// The fetch is shared by concurrent requests, so it runs on a context detached from the first request.
+	// Otherwise all waiting requests would fail if the first client disconnected.
+	ch := h.group.DoChan(fname, func() (interface{}, error) {
+		fetchCtx, cancel := context.WithTimeout(ctxzap.ToContext(context.Background(), ctxzap.Extract(ctx)), fetchTimeout)
+		defer cancel()
+
+		b, err := h.fetch(fetchCtx, workID, width)
+		if err != nil {
+			return nil, failure.Wrap(err)
+		}
+		if err := writeFile(fname, b); err != nil {
+			ctxzap.Extract(fetchCtx).Warn("failed to cache thumbnail", zap.Error(err))
+		}
+		return b, nil
+	})
+	select {
+	case <-ctx.Done():
+		return nil, time.Time{}, failure.Translate(ctx.Err(), errors.Canceled)
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, time.Time{}, failure.Wrap(res.Err)
+		}
+		return res.Val.([]byte), time.Now(), nil
+	}
+}
+
+// fetch fetches the original image of the work and resizes it to width. ctx should have a deadline.
+func (h *handler) fetch(ctx context.Context, workID int32, width int) ([]byte, error) {
+	url, err := h.annict.GetWorkImageURL(ctx, workID)
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+	if url == "" {
+		return nil, failure.New(errors.NotFound, failure.Context{"work_id": fmt.Sprint(workID)})
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, failure.Translate(err, errors.Internal)
+	}
+	res, err := h.client.Do(req)
+	if err != nil {
+		if _, ok := err.(interface{ Timeout() bool }); ok {
+			return nil, failure.Translate(err, errors.DeadlineExceeded)
+		}
+		return nil, failure.Translate(err, errors.Internal)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, failure.Unexpected(res.Status, failure.Context{"url": url})
+	}
+
+	src, err := decode(res.Body)
+	if err != nil {
+		return nil, failure.Wrap(err, failure.Context{"url": url})
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, resize(src, width), &jpeg.Options{Quality: 85}); err != nil {
+		return nil, failure.Translate(err, errors.Internal)
+	}
+	return buf.Bytes(), nil
+}
+
+// decode decodes an image of r. It rejects images larger than maxImageBytes or maxImagePixels before decoding them.
+func decode(r io.Reader) (image.Image, error) {
+	b, err := ioutil.ReadAll(io.LimitReader(r, maxImageBytes+1))
+	if err != nil {
+		return nil, failure.Translate(err, errors.Internal)
+	}
+	if len(b) > maxImageBytes {
+		return nil, failure.New(errors.Internal, failure.Message("image is too large"))
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(b))
+	if err != nil {
+		return nil, failure.Translate(err, errors.Internal)
+	}
+	if cfg.Width*cfg.Height > maxImagePixels {
+		return nil, failure.New(errors.Internal, failure.Messagef("image is too large: %dx%d", cfg.Width, cfg.Height))
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(b))
+	if err != nil {
+		return nil, failure.Translate(err, errors.Internal)
+	}
+	return img, nil
+}
+
+// resize scales src down to width keeping its aspect ratio. Images narrower than width are not scaled up.
+// Transparent pixels are drawn on a white background because JPEG doesn't support transparency.
+func resize(src image.Image, width int) image.Image {
+	b := src.Bounds()
+	height := b.Dy()
+	if b.Dx() > width {
+		height = b.Dy() * width / b.Dx()
+	} else {
+		width = b.Dx()
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, b, draw.Over, nil)
+	return dst
+}
+
+// writeFile writes b to fname atomically.
+func writeFile(fname string, b []byte) error {
+	f, err := ioutil.TempFile(filepath.Dir(fname), filepath.Base(fname))
+	if err != nil {
+		return failure.Translate(err, errors.Internal)
+	}
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return failure.Translate(err, errors.Internal)
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return failure.Translate(err, errors.Internal)
+	}
+	if err := os.Rename(f.Name(), fname); err != nil {
+		return failure.Translate(err, errors.Internal)
+	}
+	return nil
+}
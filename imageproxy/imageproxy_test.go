@@ -0,0 +1,214 @@
+package imageproxy
+
+import (
+	"context"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/GoodCodingFriends/animekai/annict"
+	"go.uber.org/zap"
+)
+
+type fakeAnnictService struct {
+	annict.Service
+	url string
+}
+
+func (s *fakeAnnictService) GetWorkImageURL(context.Context, int32) (string, error) {
+	return s.url, nil
+}
+
+func TestHandler(t *testing.T) {
+	var fetched int
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetched++
+		if err := png.Encode(w, image.NewRGBA(image.Rect(0, 0, 1280, 720))); err != nil {
+			t.Errorf("failed to encode image: '%s'", err)
+		}
+	}))
+	t.Cleanup(origin.Close)
+
+	dir, err := ioutil.TempDir("", "animekai")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	h, err := NewHandler(zap.NewNop(), dir, time.Hour, &fakeAnnictService{url: origin.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/images/6336?size=small", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status is 200, but got %d", rec.Code)
+	}
+	img, err := jpeg.Decode(rec.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w, h := img.Bounds().Dx(), img.Bounds().Dy(); w != 160 || h != 90 {
+		t.Errorf("expected size is 160x90, but got %dx%d", w, h)
+	}
+	etag := rec.Header().Get("ETag")
+	if etag == "" {
+		t.Fatal("ETag should not be empty")
+	}
+
+	// The second request is served from the cache.
+	req := httptest.NewRequest(http.MethodGet, "/images/6336?size=small", nil)
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotModified {
+		t.Errorf("expected status is 304, but got %d", rec.Code)
+	}
+	if fetched != 1 {
+		t.Errorf("the original image should be fetched only once, but fetched %d times", fetched)
+	}
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/images/6336?size=huge", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected status is 400, but got %d", rec.Code)
+	}
+}
+
+func TestHandlerTransparentImage(t *testing.T) {
+	cases := map[string]struct {
+		width, height int
+	}{
+		"resized":     {width: 1280, height: 720},
+		"not resized": {width: 100, height: 50},
+	}
+	for name, c := range cases {
+		c := c
+		t.Run(name, func(t *testing.T) {
+			origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// All pixels are transparent.
+				if err := png.Encode(w, image.NewNRGBA(image.Rect(0, 0, c.width, c.height))); err != nil {
+					t.Errorf("failed to encode image: '%s'", err)
+				}
+			}))
+			t.Cleanup(origin.Close)
+
+			dir, err := ioutil.TempDir("", "animekai")
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() { os.RemoveAll(dir) })
+
+			h, err := NewHandler(zap.NewNop(), dir, time.Hour, &fakeAnnictService{url: origin.URL})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/images/6336?size=small", nil))
+			if rec.Code != http.StatusOK {
+				t.Fatalf("expected status is 200, but got %d", rec.Code)
+			}
+			img, err := jpeg.Decode(rec.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r, g, b, _ := color.RGBAModel.Convert(img.At(0, 0)).RGBA()
+			if r>>8 < 0xf0 || g>>8 < 0xf0 || b>>8 < 0xf0 {
+				t.Errorf("transparent pixels should be white, but got (%d, %d, %d)", r>>8, g>>8, b>>8)
+			}
+		})
+	}
+}
+
+func TestHandlerRejectsHugeImage(t *testing.T) {
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The image is small, but its logical screen is 65535x65535.
+		frame := image.NewPaletted(image.Rect(0, 0, 1, 1), color.Palette{color.Black})
+		g := &gif.GIF{
+			Image:  []*image.Paletted{frame},
+			Delay:  []int{0},
+			Config: image.Config{ColorModel: frame.Palette, Width: 65535, Height: 65535},
+		}
+		if err := gif.EncodeAll(w, g); err != nil {
+			t.Errorf("failed to encode image: '%s'", err)
+		}
+	}))
+	t.Cleanup(origin.Close)
+
+	dir, err := ioutil.TempDir("", "animekai")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	h, err := NewHandler(zap.NewNop(), dir, time.Hour, &fakeAnnictService{url: origin.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/images/6336?size=small", nil))
+	if rec.Code != http.StatusBadGateway {
+		t.Errorf("expected status is 502, but got %d", rec.Code)
+	}
+}
+
+func TestHandlerSharesFetchAfterCancel(t *testing.T) {
+	var (
+		requested = make(chan struct{}, 1)
+		release   = make(chan struct{})
+	)
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested <- struct{}{}
+		<-release
+		if err := png.Encode(w, image.NewRGBA(image.Rect(0, 0, 1280, 720))); err != nil {
+			t.Errorf("failed to encode image: '%s'", err)
+		}
+	}))
+	t.Cleanup(origin.Close)
+
+	dir, err := ioutil.TempDir("", "animekai")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	h, err := NewHandler(zap.NewNop(), dir, time.Hour, &fakeAnnictService{url: origin.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The first client disconnects while the original image is being fetched.
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan int)
+	go func() {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/images/6336?size=small", nil).WithContext(ctx))
+		first <- rec.Code
+	}()
+	<-requested
+
+	second := make(chan int)
+	go func() {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/images/6336?size=small", nil))
+		second <- rec.Code
+	}()
+	cancel()
+	<-first
+	close(release)
+
+	if code := <-second; code != http.StatusOK {
+		t.Errorf("the waiting request should succeed, but got status %d", code)
+	}
+}
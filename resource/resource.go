@@ -1,6 +1,7 @@
 package resource
 
 type Episode struct {
+	WorkID     int32
 	WorkTitle  string
 	Title      string
 	NumberText string
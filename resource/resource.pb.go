@@ -32,6 +32,8 @@ const (
 	Work_STATUS_UNSPECIFIED Work_Status = 0
 	Work_WATCHING           Work_Status = 1
 	Work_WATCHED            Work_Status = 2
+	// Stopped watching the work.
+	Work_DROPPED Work_Status = 3
 )
 
 // Enum value maps for Work_Status.
@@ -40,11 +42,13 @@ var (
 		0: "STATUS_UNSPECIFIED",
 		1: "WATCHING",
 		2: "WATCHED",
+		3: "DROPPED",
 	}
 	Work_Status_value = map[string]int32{
 		"STATUS_UNSPECIFIED": 0,
 		"WATCHING":           1,
 		"WATCHED":            2,
+		"DROPPED":            3,
 	}
 )
 
@@ -75,6 +79,270 @@ func (Work_Status) EnumDescriptor() ([]byte, []int) {
 	return file_resource_proto_rawDescGZIP(), []int{1, 0}
 }
 
+type Work_Season int32
+
+const (
+	Work_SEASON_UNSPECIFIED Work_Season = 0
+	Work_WINTER             Work_Season = 1
+	Work_SPRING             Work_Season = 2
+	Work_SUMMER             Work_Season = 3
+	Work_AUTUMN             Work_Season = 4
+)
+
+// Enum value maps for Work_Season.
+var (
+	Work_Season_name = map[int32]string{
+		0: "SEASON_UNSPECIFIED",
+		1: "WINTER",
+		2: "SPRING",
+		3: "SUMMER",
+		4: "AUTUMN",
+	}
+	Work_Season_value = map[string]int32{
+		"SEASON_UNSPECIFIED": 0,
+		"WINTER":             1,
+		"SPRING":             2,
+		"SUMMER":             3,
+		"AUTUMN":             4,
+	}
+)
+
+func (x Work_Season) Enum() *Work_Season {
+	p := new(Work_Season)
+	*p = x
+	return p
+}
+
+func (x Work_Season) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Work_Season) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_proto_enumTypes[1].Descriptor()
+}
+
+func (Work_Season) Type() protoreflect.EnumType {
+	return &file_resource_proto_enumTypes[1]
+}
+
+func (x Work_Season) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Work_Season.Descriptor instead.
+func (Work_Season) EnumDescriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{1, 1}
+}
+
+type Streak_Granularity int32
+
+const (
+	Streak_GRANULARITY_UNSPECIFIED Streak_Granularity = 0
+	Streak_DAY                     Streak_Granularity = 1
+	Streak_WEEK                    Streak_Granularity = 2
+)
+
+// Enum value maps for Streak_Granularity.
+var (
+	Streak_Granularity_name = map[int32]string{
+		0: "GRANULARITY_UNSPECIFIED",
+		1: "DAY",
+		2: "WEEK",
+	}
+	Streak_Granularity_value = map[string]int32{
+		"GRANULARITY_UNSPECIFIED": 0,
+		"DAY":                     1,
+		"WEEK":                    2,
+	}
+)
+
+func (x Streak_Granularity) Enum() *Streak_Granularity {
+	p := new(Streak_Granularity)
+	*p = x
+	return p
+}
+
+func (x Streak_Granularity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Streak_Granularity) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_proto_enumTypes[2].Descriptor()
+}
+
+func (Streak_Granularity) Type() protoreflect.EnumType {
+	return &file_resource_proto_enumTypes[2]
+}
+
+func (x Streak_Granularity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Streak_Granularity.Descriptor instead.
+func (Streak_Granularity) EnumDescriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{3, 0}
+}
+
+type Review_Rating int32
+
+const (
+	Review_RATING_UNSPECIFIED Review_Rating = 0
+	Review_BAD                Review_Rating = 1
+	Review_AVERAGE            Review_Rating = 2
+	Review_GOOD               Review_Rating = 3
+	Review_GREAT              Review_Rating = 4
+)
+
+// Enum value maps for Review_Rating.
+var (
+	Review_Rating_name = map[int32]string{
+		0: "RATING_UNSPECIFIED",
+		1: "BAD",
+		2: "AVERAGE",
+		3: "GOOD",
+		4: "GREAT",
+	}
+	Review_Rating_value = map[string]int32{
+		"RATING_UNSPECIFIED": 0,
+		"BAD":                1,
+		"AVERAGE":            2,
+		"GOOD":               3,
+		"GREAT":              4,
+	}
+)
+
+func (x Review_Rating) Enum() *Review_Rating {
+	p := new(Review_Rating)
+	*p = x
+	return p
+}
+
+func (x Review_Rating) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Review_Rating) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_proto_enumTypes[3].Descriptor()
+}
+
+func (Review_Rating) Type() protoreflect.EnumType {
+	return &file_resource_proto_enumTypes[3]
+}
+
+func (x Review_Rating) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Review_Rating.Descriptor instead.
+func (Review_Rating) EnumDescriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{4, 0}
+}
+
+type Staff_Role int32
+
+const (
+	Staff_ROLE_UNSPECIFIED Staff_Role = 0
+	// Animation production.
+	Staff_STUDIO             Staff_Role = 1
+	Staff_DIRECTOR           Staff_Role = 2
+	Staff_COMPOSER           Staff_Role = 3
+	Staff_SERIES_COMPOSITION Staff_Role = 4
+)
+
+// Enum value maps for Staff_Role.
+var (
+	Staff_Role_name = map[int32]string{
+		0: "ROLE_UNSPECIFIED",
+		1: "STUDIO",
+		2: "DIRECTOR",
+		3: "COMPOSER",
+		4: "SERIES_COMPOSITION",
+	}
+	Staff_Role_value = map[string]int32{
+		"ROLE_UNSPECIFIED":   0,
+		"STUDIO":             1,
+		"DIRECTOR":           2,
+		"COMPOSER":           3,
+		"SERIES_COMPOSITION": 4,
+	}
+)
+
+func (x Staff_Role) Enum() *Staff_Role {
+	p := new(Staff_Role)
+	*p = x
+	return p
+}
+
+func (x Staff_Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Staff_Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_proto_enumTypes[4].Descriptor()
+}
+
+func (Staff_Role) Type() protoreflect.EnumType {
+	return &file_resource_proto_enumTypes[4]
+}
+
+func (x Staff_Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Staff_Role.Descriptor instead.
+func (Staff_Role) EnumDescriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{10, 0}
+}
+
+type Staff_Type int32
+
+const (
+	Staff_TYPE_UNSPECIFIED Staff_Type = 0
+	Staff_PERSON           Staff_Type = 1
+	Staff_ORGANIZATION     Staff_Type = 2
+)
+
+// Enum value maps for Staff_Type.
+var (
+	Staff_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "PERSON",
+		2: "ORGANIZATION",
+	}
+	Staff_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"PERSON":           1,
+		"ORGANIZATION":     2,
+	}
+)
+
+func (x Staff_Type) Enum() *Staff_Type {
+	p := new(Staff_Type)
+	*p = x
+	return p
+}
+
+func (x Staff_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Staff_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_proto_enumTypes[5].Descriptor()
+}
+
+func (Staff_Type) Type() protoreflect.EnumType {
+	return &file_resource_proto_enumTypes[5]
+}
+
+func (x Staff_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Staff_Type.Descriptor instead.
+func (Staff_Type) EnumDescriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{10, 1}
+}
+
 type Profile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -184,8 +452,14 @@ type Work struct {
 	BeginTime *timestamp.Timestamp `protobuf:"bytes,9,opt,name=begin_time,json=beginTime,proto3" json:"begin_time,omitempty"`
 	// Time when finished watching the work. Empty if status is WATCHING.
 	FinishTime *timestamp.Timestamp `protobuf:"bytes,10,opt,name=finish_time,json=finishTime,proto3" json:"finish_time,omitempty"`
-	// Status which indicates that the work is watched/watching.
+	// Status which indicates that the work is watched/watching/dropped.
 	Status Work_Status `protobuf:"varint,11,opt,name=status,proto3,enum=resource.Work_Status" json:"status,omitempty"`
+	// How number of episodes are already watched.
+	WatchedEpisodesCount int32 `protobuf:"varint,12,opt,name=watched_episodes_count,json=watchedEpisodesCount,proto3" json:"watched_episodes_count,omitempty"`
+	// Year when the work is released. 0 if it is unknown.
+	SeasonYear int32 `protobuf:"varint,13,opt,name=season_year,json=seasonYear,proto3" json:"season_year,omitempty"`
+	// Season when the work is released. SEASON_UNSPECIFIED if it is unknown.
+	SeasonName Work_Season `protobuf:"varint,14,opt,name=season_name,json=seasonName,proto3,enum=resource.Work_Season" json:"season_name,omitempty"`
 }
 
 func (x *Work) Reset() {
@@ -297,6 +571,27 @@ func (x *Work) GetStatus() Work_Status {
 	return Work_STATUS_UNSPECIFIED
 }
 
+func (x *Work) GetWatchedEpisodesCount() int32 {
+	if x != nil {
+		return x.WatchedEpisodesCount
+	}
+	return 0
+}
+
+func (x *Work) GetSeasonYear() int32 {
+	if x != nil {
+		return x.SeasonYear
+	}
+	return 0
+}
+
+func (x *Work) GetSeasonName() Work_Season {
+	if x != nil {
+		return x.SeasonName
+	}
+	return Work_SEASON_UNSPECIFIED
+}
+
 type Dashboard struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -305,6 +600,7 @@ type Dashboard struct {
 	Profile       *Profile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
 	WatchingWorks []*Work  `protobuf:"bytes,2,rep,name=watching_works,json=watchingWorks,proto3" json:"watching_works,omitempty"`
 	WatchedWorks  []*Work  `protobuf:"bytes,3,rep,name=watched_works,json=watchedWorks,proto3" json:"watched_works,omitempty"`
+	Streak        *Streak  `protobuf:"bytes,4,opt,name=streak,proto3" json:"streak,omitempty"`
 }
 
 func (x *Dashboard) Reset() {
@@ -360,140 +656,1684 @@ func (x *Dashboard) GetWatchedWorks() []*Work {
 	return nil
 }
 
-var File_resource_proto protoreflect.FileDescriptor
+func (x *Dashboard) GetStreak() *Streak {
+	if x != nil {
+		return x.Streak
+	}
+	return nil
+}
 
-var file_resource_proto_rawDesc = []byte{
-	0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc5, 0x01, 0x0a, 0x07,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x76, 0x61, 0x74, 0x61,
-	0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x76, 0x61,
-	0x74, 0x61, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x77,
-	0x61, 0x6e, 0x6e, 0x61, 0x5f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x77, 0x61, 0x6e, 0x6e, 0x61, 0x57, 0x61, 0x74,
-	0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x61, 0x74, 0x63, 0x68,
-	0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0d, 0x77, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23,
-	0x0a, 0x0d, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0xec, 0x03, 0x0a, 0x04, 0x57, 0x6f, 0x72, 0x6b, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12,
-	0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x4f, 0x6e,
-	0x12, 0x25, 0x0a, 0x0e, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64,
-	0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x6e, 0x6e, 0x69, 0x63,
-	0x74, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x61, 0x6e, 0x6e, 0x69, 0x63, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x2a, 0x0a,
-	0x11, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x75,
-	0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x69,
-	0x61, 0x6c, 0x53, 0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x6b,
-	0x69, 0x70, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x77, 0x69, 0x6b, 0x69, 0x70, 0x65, 0x64, 0x69, 0x61, 0x55, 0x72, 0x6c, 0x12, 0x39,
-	0x0a, 0x0a, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x62, 0x65, 0x67, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x66, 0x69, 0x6e,
-	0x69, 0x73, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69,
-	0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3b, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x57, 0x41, 0x54, 0x43, 0x48,
-	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x54, 0x43, 0x48, 0x45, 0x44,
-	0x10, 0x02, 0x22, 0xa4, 0x01, 0x0a, 0x09, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64,
-	0x12, 0x2b, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x35, 0x0a,
-	0x0e, 0x77, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x52, 0x0d, 0x77, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x57,
-	0x6f, 0x72, 0x6b, 0x73, 0x12, 0x33, 0x0a, 0x0d, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f,
-	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x52, 0x0c, 0x77, 0x61, 0x74,
-	0x63, 0x68, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x47, 0x6f, 0x6f, 0x64, 0x43, 0x6f, 0x64, 0x69,
-	0x6e, 0x67, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x2f, 0x61, 0x6e, 0x69, 0x6d, 0x65, 0x6b,
-	0x61, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+// Streak is the number of consecutive days or weeks in which any episodes are recorded.
+// Days and weeks are based on dates in JST, and weeks start on Monday.
+type Streak struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unit of the streak.
+	Granularity Streak_Granularity `protobuf:"varint,1,opt,name=granularity,proto3,enum=resource.Streak_Granularity" json:"granularity,omitempty"`
+	// Length of the streak which continues to the current day or week.
+	// The current day or week without records doesn't break the streak until it ends.
+	Current int32 `protobuf:"varint,2,opt,name=current,proto3" json:"current,omitempty"`
+	// Length of the longest streak.
+	Longest int32 `protobuf:"varint,3,opt,name=longest,proto3" json:"longest,omitempty"`
 }
 
-var (
-	file_resource_proto_rawDescOnce sync.Once
-	file_resource_proto_rawDescData = file_resource_proto_rawDesc
-)
+func (x *Streak) Reset() {
+	*x = Streak{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_resource_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_resource_proto_rawDescGZIP() []byte {
-	file_resource_proto_rawDescOnce.Do(func() {
-		file_resource_proto_rawDescData = protoimpl.X.CompressGZIP(file_resource_proto_rawDescData)
-	})
-	return file_resource_proto_rawDescData
+func (x *Streak) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_resource_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_resource_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
-var file_resource_proto_goTypes = []interface{}{
-	(Work_Status)(0),            // 0: resource.Work.Status
-	(*Profile)(nil),             // 1: resource.Profile
-	(*Work)(nil),                // 2: resource.Work
-	(*Dashboard)(nil),           // 3: resource.Dashboard
-	(*timestamp.Timestamp)(nil), // 4: google.protobuf.Timestamp
+func (*Streak) ProtoMessage() {}
+
+func (x *Streak) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_resource_proto_depIdxs = []int32{
-	4, // 0: resource.Work.begin_time:type_name -> google.protobuf.Timestamp
-	4, // 1: resource.Work.finish_time:type_name -> google.protobuf.Timestamp
-	0, // 2: resource.Work.status:type_name -> resource.Work.Status
-	1, // 3: resource.Dashboard.profile:type_name -> resource.Profile
-	2, // 4: resource.Dashboard.watching_works:type_name -> resource.Work
-	2, // 5: resource.Dashboard.watched_works:type_name -> resource.Work
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+
+// Deprecated: Use Streak.ProtoReflect.Descriptor instead.
+func (*Streak) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{3}
 }
 
-func init() { file_resource_proto_init() }
-func file_resource_proto_init() {
-	if File_resource_proto != nil {
-		return
+func (x *Streak) GetGranularity() Streak_Granularity {
+	if x != nil {
+		return x.Granularity
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_resource_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Profile); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_resource_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Work); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_resource_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Dashboard); i {
+	return Streak_GRANULARITY_UNSPECIFIED
+}
+
+func (x *Streak) GetCurrent() int32 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *Streak) GetLongest() int32 {
+	if x != nil {
+		return x.Longest
+	}
+	return 0
+}
+
+type Review struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Review's identifier for Annict.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Identifier of the reviewed work.
+	WorkId int32 `protobuf:"varint,2,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	// Title of the reviewed work.
+	WorkTitle string `protobuf:"bytes,3,opt,name=work_title,json=workTitle,proto3" json:"work_title,omitempty"`
+	// Body of the review.
+	Body string `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	// Overall rating of the work.
+	OverallRating Review_Rating `protobuf:"varint,5,opt,name=overall_rating,json=overallRating,proto3,enum=resource.Review_Rating" json:"overall_rating,omitempty"`
+	// Rating of the animation.
+	AnimationRating Review_Rating `protobuf:"varint,6,opt,name=animation_rating,json=animationRating,proto3,enum=resource.Review_Rating" json:"animation_rating,omitempty"`
+	// Rating of the music.
+	MusicRating Review_Rating `protobuf:"varint,7,opt,name=music_rating,json=musicRating,proto3,enum=resource.Review_Rating" json:"music_rating,omitempty"`
+	// Rating of the story.
+	StoryRating Review_Rating `protobuf:"varint,8,opt,name=story_rating,json=storyRating,proto3,enum=resource.Review_Rating" json:"story_rating,omitempty"`
+	// Rating of the characters.
+	CharacterRating Review_Rating `protobuf:"varint,9,opt,name=character_rating,json=characterRating,proto3,enum=resource.Review_Rating" json:"character_rating,omitempty"`
+	// Time when the review is created.
+	CreateTime *timestamp.Timestamp `protobuf:"bytes,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_resource_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Review) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Review) GetWorkId() int32 {
+	if x != nil {
+		return x.WorkId
+	}
+	return 0
+}
+
+func (x *Review) GetWorkTitle() string {
+	if x != nil {
+		return x.WorkTitle
+	}
+	return ""
+}
+
+func (x *Review) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Review) GetOverallRating() Review_Rating {
+	if x != nil {
+		return x.OverallRating
+	}
+	return Review_RATING_UNSPECIFIED
+}
+
+func (x *Review) GetAnimationRating() Review_Rating {
+	if x != nil {
+		return x.AnimationRating
+	}
+	return Review_RATING_UNSPECIFIED
+}
+
+func (x *Review) GetMusicRating() Review_Rating {
+	if x != nil {
+		return x.MusicRating
+	}
+	return Review_RATING_UNSPECIFIED
+}
+
+func (x *Review) GetStoryRating() Review_Rating {
+	if x != nil {
+		return x.StoryRating
+	}
+	return Review_RATING_UNSPECIFIED
+}
+
+func (x *Review) GetCharacterRating() Review_Rating {
+	if x != nil {
+		return x.CharacterRating
+	}
+	return Review_RATING_UNSPECIFIED
+}
+
+func (x *Review) GetCreateTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type Record struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Record's identifier for Annict.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Identifier of the recorded work.
+	WorkId int32 `protobuf:"varint,2,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	// Title of the recorded work.
+	WorkTitle string `protobuf:"bytes,3,opt,name=work_title,json=workTitle,proto3" json:"work_title,omitempty"`
+	// Sort number of the recorded episode.
+	EpisodeSortNumber int32 `protobuf:"varint,4,opt,name=episode_sort_number,json=episodeSortNumber,proto3" json:"episode_sort_number,omitempty"`
+	// Whether the record is the first record of the work.
+	FirstRecord bool `protobuf:"varint,5,opt,name=first_record,json=firstRecord,proto3" json:"first_record,omitempty"`
+	// Whether the recorded episode is the last episode of the work.
+	LastEpisode bool `protobuf:"varint,6,opt,name=last_episode,json=lastEpisode,proto3" json:"last_episode,omitempty"`
+	// Time when the record is created.
+	CreateTime *timestamp.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+}
+
+func (x *Record) Reset() {
+	*x = Record{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_resource_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Record) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record) ProtoMessage() {}
+
+func (x *Record) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Record.ProtoReflect.Descriptor instead.
+func (*Record) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Record) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Record) GetWorkId() int32 {
+	if x != nil {
+		return x.WorkId
+	}
+	return 0
+}
+
+func (x *Record) GetWorkTitle() string {
+	if x != nil {
+		return x.WorkTitle
+	}
+	return ""
+}
+
+func (x *Record) GetEpisodeSortNumber() int32 {
+	if x != nil {
+		return x.EpisodeSortNumber
+	}
+	return 0
+}
+
+func (x *Record) GetFirstRecord() bool {
+	if x != nil {
+		return x.FirstRecord
+	}
+	return false
+}
+
+func (x *Record) GetLastEpisode() bool {
+	if x != nil {
+		return x.LastEpisode
+	}
+	return false
+}
+
+func (x *Record) GetCreateTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type Program struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Program's identifier for Annict.
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Identifier of the broadcast work.
+	WorkId int32 `protobuf:"varint,2,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	// Title of the broadcast work.
+	WorkTitle string `protobuf:"bytes,3,opt,name=work_title,json=workTitle,proto3" json:"work_title,omitempty"`
+	// Number of the broadcast episode such as "第1話".
+	EpisodeNumberText string `protobuf:"bytes,4,opt,name=episode_number_text,json=episodeNumberText,proto3" json:"episode_number_text,omitempty"`
+	// Title of the broadcast episode.
+	EpisodeTitle string `protobuf:"bytes,5,opt,name=episode_title,json=episodeTitle,proto3" json:"episode_title,omitempty"`
+	// Name of the channel which broadcasts the program.
+	ChannelName string `protobuf:"bytes,6,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	// Whether the program is a rebroadcast.
+	Rebroadcast bool `protobuf:"varint,7,opt,name=rebroadcast,proto3" json:"rebroadcast,omitempty"`
+	// Time when the program starts.
+	StartTime *timestamp.Timestamp `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+}
+
+func (x *Program) Reset() {
+	*x = Program{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_resource_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Program) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Program) ProtoMessage() {}
+
+func (x *Program) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Program.ProtoReflect.Descriptor instead.
+func (*Program) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Program) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Program) GetWorkId() int32 {
+	if x != nil {
+		return x.WorkId
+	}
+	return 0
+}
+
+func (x *Program) GetWorkTitle() string {
+	if x != nil {
+		return x.WorkTitle
+	}
+	return ""
+}
+
+func (x *Program) GetEpisodeNumberText() string {
+	if x != nil {
+		return x.EpisodeNumberText
+	}
+	return ""
+}
+
+func (x *Program) GetEpisodeTitle() string {
+	if x != nil {
+		return x.EpisodeTitle
+	}
+	return ""
+}
+
+func (x *Program) GetChannelName() string {
+	if x != nil {
+		return x.ChannelName
+	}
+	return ""
+}
+
+func (x *Program) GetRebroadcast() bool {
+	if x != nil {
+		return x.Rebroadcast
+	}
+	return false
+}
+
+func (x *Program) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+// Activity is the number of recorded episodes per period, which is used for heatmaps.
+// Periods are based on dates in JST, and periods without records are omitted.
+type Activity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Counts per day in chronological order.
+	Daily []*Activity_Count `protobuf:"bytes,1,rep,name=daily,proto3" json:"daily,omitempty"`
+	// Counts per week in chronological order. Weeks start on Monday.
+	Weekly []*Activity_Count `protobuf:"bytes,2,rep,name=weekly,proto3" json:"weekly,omitempty"`
+	// Counts per month in chronological order.
+	Monthly []*Activity_Count `protobuf:"bytes,3,rep,name=monthly,proto3" json:"monthly,omitempty"`
+}
+
+func (x *Activity) Reset() {
+	*x = Activity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_resource_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Activity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Activity) ProtoMessage() {}
+
+func (x *Activity) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Activity.ProtoReflect.Descriptor instead.
+func (*Activity) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Activity) GetDaily() []*Activity_Count {
+	if x != nil {
+		return x.Daily
+	}
+	return nil
+}
+
+func (x *Activity) GetWeekly() []*Activity_Count {
+	if x != nil {
+		return x.Weekly
+	}
+	return nil
+}
+
+func (x *Activity) GetMonthly() []*Activity_Count {
+	if x != nil {
+		return x.Monthly
+	}
+	return nil
+}
+
+// CompletionStats describes how long it took to finish works.
+// Days are counted by dates in JST, so a work which is begun and finished on the same date takes 1 day.
+type CompletionStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Finished works in descending order of days.
+	FinishedWorks []*CompletionStats_Work `protobuf:"bytes,1,rep,name=finished_works,json=finishedWorks,proto3" json:"finished_works,omitempty"`
+	// Median of days of all finished works.
+	MedianDays float64 `protobuf:"fixed64,2,opt,name=median_days,json=medianDays,proto3" json:"median_days,omitempty"`
+	// The finished work which took the longest days.
+	LongestWork *CompletionStats_Work `protobuf:"bytes,3,opt,name=longest_work,json=longestWork,proto3" json:"longest_work,omitempty"`
+	// WATCHING works in descending order of days since they are begun.
+	WatchingWorks []*CompletionStats_Work `protobuf:"bytes,4,rep,name=watching_works,json=watchingWorks,proto3" json:"watching_works,omitempty"`
+}
+
+func (x *CompletionStats) Reset() {
+	*x = CompletionStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_resource_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompletionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletionStats) ProtoMessage() {}
+
+func (x *CompletionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletionStats.ProtoReflect.Descriptor instead.
+func (*CompletionStats) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CompletionStats) GetFinishedWorks() []*CompletionStats_Work {
+	if x != nil {
+		return x.FinishedWorks
+	}
+	return nil
+}
+
+func (x *CompletionStats) GetMedianDays() float64 {
+	if x != nil {
+		return x.MedianDays
+	}
+	return 0
+}
+
+func (x *CompletionStats) GetLongestWork() *CompletionStats_Work {
+	if x != nil {
+		return x.LongestWork
+	}
+	return nil
+}
+
+func (x *CompletionStats) GetWatchingWorks() []*CompletionStats_Work {
+	if x != nil {
+		return x.WatchingWorks
+	}
+	return nil
+}
+
+// SeasonBreakdown groups works by seasons when they are released.
+type SeasonBreakdown struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Seasons in chronological order. Seasons without works are omitted.
+	Seasons []*SeasonBreakdown_Season `protobuf:"bytes,1,rep,name=seasons,proto3" json:"seasons,omitempty"`
+}
+
+func (x *SeasonBreakdown) Reset() {
+	*x = SeasonBreakdown{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_resource_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeasonBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeasonBreakdown) ProtoMessage() {}
+
+func (x *SeasonBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeasonBreakdown.ProtoReflect.Descriptor instead.
+func (*SeasonBreakdown) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SeasonBreakdown) GetSeasons() []*SeasonBreakdown_Season {
+	if x != nil {
+		return x.Seasons
+	}
+	return nil
+}
+
+// Staff is a person or an organization credited for a work.
+type Staff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifier of the person or the organization for Annict. Persons and organizations have separate identifiers,
+	// so a staff is identified by the pair of id and type.
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name of the person or the organization.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Role in the work.
+	Role Staff_Role `protobuf:"varint,3,opt,name=role,proto3,enum=resource.Staff_Role" json:"role,omitempty"`
+	// Identifier of the work.
+	WorkId int32 `protobuf:"varint,4,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	// Title of the work.
+	WorkTitle string `protobuf:"bytes,5,opt,name=work_title,json=workTitle,proto3" json:"work_title,omitempty"`
+	// Whether the staff is a person or an organization.
+	Type Staff_Type `protobuf:"varint,6,opt,name=type,proto3,enum=resource.Staff_Type" json:"type,omitempty"`
+}
+
+func (x *Staff) Reset() {
+	*x = Staff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_resource_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Staff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Staff) ProtoMessage() {}
+
+func (x *Staff) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Staff.ProtoReflect.Descriptor instead.
+func (*Staff) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Staff) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Staff) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Staff) GetRole() Staff_Role {
+	if x != nil {
+		return x.Role
+	}
+	return Staff_ROLE_UNSPECIFIED
+}
+
+func (x *Staff) GetWorkId() int32 {
+	if x != nil {
+		return x.WorkId
+	}
+	return 0
+}
+
+func (x *Staff) GetWorkTitle() string {
+	if x != nil {
+		return x.WorkTitle
+	}
+	return ""
+}
+
+func (x *Staff) GetType() Staff_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Staff_TYPE_UNSPECIFIED
+}
+
+// StaffStats ranks staffs by the number of watched works.
+type StaffStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Entries are in descending order of works_count.
+	Studios                  []*StaffStats_Entry `protobuf:"bytes,1,rep,name=studios,proto3" json:"studios,omitempty"`
+	Directors                []*StaffStats_Entry `protobuf:"bytes,2,rep,name=directors,proto3" json:"directors,omitempty"`
+	Composers                []*StaffStats_Entry `protobuf:"bytes,3,rep,name=composers,proto3" json:"composers,omitempty"`
+	SeriesCompositionWriters []*StaffStats_Entry `protobuf:"bytes,4,rep,name=series_composition_writers,json=seriesCompositionWriters,proto3" json:"series_composition_writers,omitempty"`
+}
+
+func (x *StaffStats) Reset() {
+	*x = StaffStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_resource_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StaffStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaffStats) ProtoMessage() {}
+
+func (x *StaffStats) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaffStats.ProtoReflect.Descriptor instead.
+func (*StaffStats) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StaffStats) GetStudios() []*StaffStats_Entry {
+	if x != nil {
+		return x.Studios
+	}
+	return nil
+}
+
+func (x *StaffStats) GetDirectors() []*StaffStats_Entry {
+	if x != nil {
+		return x.Directors
+	}
+	return nil
+}
+
+func (x *StaffStats) GetComposers() []*StaffStats_Entry {
+	if x != nil {
+		return x.Composers
+	}
+	return nil
+}
+
+func (x *StaffStats) GetSeriesCompositionWriters() []*StaffStats_Entry {
+	if x != nil {
+		return x.SeriesCompositionWriters
+	}
+	return nil
+}
+
+type Activity_Count struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The first date of the period in YYYY-MM-DD format.
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Number of episodes recorded in the period.
+	EpisodesCount int32 `protobuf:"varint,2,opt,name=episodes_count,json=episodesCount,proto3" json:"episodes_count,omitempty"`
+}
+
+func (x *Activity_Count) Reset() {
+	*x = Activity_Count{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_resource_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Activity_Count) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Activity_Count) ProtoMessage() {}
+
+func (x *Activity_Count) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Activity_Count.ProtoReflect.Descriptor instead.
+func (*Activity_Count) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *Activity_Count) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Activity_Count) GetEpisodesCount() int32 {
+	if x != nil {
+		return x.EpisodesCount
+	}
+	return 0
+}
+
+type CompletionStats_Work struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Work's identifier.
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Work's title.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// Number of recorded episodes.
+	EpisodesCount int32 `protobuf:"varint,3,opt,name=episodes_count,json=episodesCount,proto3" json:"episodes_count,omitempty"`
+	// Days from the first record to the last episode record, or to today if the work is still watched.
+	Days int32 `protobuf:"varint,4,opt,name=days,proto3" json:"days,omitempty"`
+	// Average number of recorded episodes per day.
+	EpisodesPerDay float64 `protobuf:"fixed64,5,opt,name=episodes_per_day,json=episodesPerDay,proto3" json:"episodes_per_day,omitempty"`
+	// Time when began watching the work.
+	BeginTime *timestamp.Timestamp `protobuf:"bytes,6,opt,name=begin_time,json=beginTime,proto3" json:"begin_time,omitempty"`
+	// Time when finished watching the work. Empty if the work is still watched.
+	FinishTime *timestamp.Timestamp `protobuf:"bytes,7,opt,name=finish_time,json=finishTime,proto3" json:"finish_time,omitempty"`
+}
+
+func (x *CompletionStats_Work) Reset() {
+	*x = CompletionStats_Work{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_resource_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompletionStats_Work) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletionStats_Work) ProtoMessage() {}
+
+func (x *CompletionStats_Work) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletionStats_Work.ProtoReflect.Descriptor instead.
+func (*CompletionStats_Work) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *CompletionStats_Work) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CompletionStats_Work) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CompletionStats_Work) GetEpisodesCount() int32 {
+	if x != nil {
+		return x.EpisodesCount
+	}
+	return 0
+}
+
+func (x *CompletionStats_Work) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *CompletionStats_Work) GetEpisodesPerDay() float64 {
+	if x != nil {
+		return x.EpisodesPerDay
+	}
+	return 0
+}
+
+func (x *CompletionStats_Work) GetBeginTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.BeginTime
+	}
+	return nil
+}
+
+func (x *CompletionStats_Work) GetFinishTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.FinishTime
+	}
+	return nil
+}
+
+type SeasonBreakdown_Season struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Year when works are released. 0 for works whose seasons are unknown.
+	Year int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	// Season when works are released.
+	Season Work_Season `protobuf:"varint,2,opt,name=season,proto3,enum=resource.Work_Season" json:"season,omitempty"`
+	// Number of watching works.
+	WatchingCount int32 `protobuf:"varint,3,opt,name=watching_count,json=watchingCount,proto3" json:"watching_count,omitempty"`
+	// Number of watched works.
+	WatchedCount int32 `protobuf:"varint,4,opt,name=watched_count,json=watchedCount,proto3" json:"watched_count,omitempty"`
+	// Number of dropped works.
+	DroppedCount int32 `protobuf:"varint,5,opt,name=dropped_count,json=droppedCount,proto3" json:"dropped_count,omitempty"`
+	// Total number of episodes of the works.
+	EpisodesCount int32 `protobuf:"varint,6,opt,name=episodes_count,json=episodesCount,proto3" json:"episodes_count,omitempty"`
+	// Total number of watched episodes of the works.
+	WatchedEpisodesCount int32 `protobuf:"varint,7,opt,name=watched_episodes_count,json=watchedEpisodesCount,proto3" json:"watched_episodes_count,omitempty"`
+}
+
+func (x *SeasonBreakdown_Season) Reset() {
+	*x = SeasonBreakdown_Season{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_resource_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeasonBreakdown_Season) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeasonBreakdown_Season) ProtoMessage() {}
+
+func (x *SeasonBreakdown_Season) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeasonBreakdown_Season.ProtoReflect.Descriptor instead.
+func (*SeasonBreakdown_Season) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *SeasonBreakdown_Season) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *SeasonBreakdown_Season) GetSeason() Work_Season {
+	if x != nil {
+		return x.Season
+	}
+	return Work_SEASON_UNSPECIFIED
+}
+
+func (x *SeasonBreakdown_Season) GetWatchingCount() int32 {
+	if x != nil {
+		return x.WatchingCount
+	}
+	return 0
+}
+
+func (x *SeasonBreakdown_Season) GetWatchedCount() int32 {
+	if x != nil {
+		return x.WatchedCount
+	}
+	return 0
+}
+
+func (x *SeasonBreakdown_Season) GetDroppedCount() int32 {
+	if x != nil {
+		return x.DroppedCount
+	}
+	return 0
+}
+
+func (x *SeasonBreakdown_Season) GetEpisodesCount() int32 {
+	if x != nil {
+		return x.EpisodesCount
+	}
+	return 0
+}
+
+func (x *SeasonBreakdown_Season) GetWatchedEpisodesCount() int32 {
+	if x != nil {
+		return x.WatchedEpisodesCount
+	}
+	return 0
+}
+
+type StaffStats_Entry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifier of the person or the organization for Annict.
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name of the person or the organization.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Number of watched works.
+	WorksCount int32 `protobuf:"varint,3,opt,name=works_count,json=worksCount,proto3" json:"works_count,omitempty"`
+	// Titles of the watched works.
+	WorkTitles []string `protobuf:"bytes,4,rep,name=work_titles,json=workTitles,proto3" json:"work_titles,omitempty"`
+	// Whether the staff is a person or an organization.
+	Type Staff_Type `protobuf:"varint,5,opt,name=type,proto3,enum=resource.Staff_Type" json:"type,omitempty"`
+}
+
+func (x *StaffStats_Entry) Reset() {
+	*x = StaffStats_Entry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_resource_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StaffStats_Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaffStats_Entry) ProtoMessage() {}
+
+func (x *StaffStats_Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaffStats_Entry.ProtoReflect.Descriptor instead.
+func (*StaffStats_Entry) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *StaffStats_Entry) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StaffStats_Entry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StaffStats_Entry) GetWorksCount() int32 {
+	if x != nil {
+		return x.WorksCount
+	}
+	return 0
+}
+
+func (x *StaffStats_Entry) GetWorkTitles() []string {
+	if x != nil {
+		return x.WorkTitles
+	}
+	return nil
+}
+
+func (x *StaffStats_Entry) GetType() Staff_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Staff_TYPE_UNSPECIFIED
+}
+
+var File_resource_proto protoreflect.FileDescriptor
+
+var file_resource_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc5, 0x01, 0x0a, 0x07,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x76, 0x61, 0x74, 0x61,
+	0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x76, 0x61,
+	0x74, 0x61, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x77,
+	0x61, 0x6e, 0x6e, 0x61, 0x5f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x77, 0x61, 0x6e, 0x6e, 0x61, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x61, 0x74, 0x63, 0x68,
+	0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x77, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0xda, 0x05, 0x0a, 0x04, 0x57, 0x6f, 0x72, 0x6b, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12,
+	0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x4f, 0x6e,
+	0x12, 0x25, 0x0a, 0x0e, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64,
+	0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x6e, 0x6e, 0x69, 0x63,
+	0x74, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x61, 0x6e, 0x6e, 0x69, 0x63, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x2a, 0x0a,
+	0x11, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x69,
+	0x61, 0x6c, 0x53, 0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x6b,
+	0x69, 0x70, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x77, 0x69, 0x6b, 0x69, 0x70, 0x65, 0x64, 0x69, 0x61, 0x55, 0x72, 0x6c, 0x12, 0x39,
+	0x0a, 0x0a, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x62, 0x65, 0x67, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x5f, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x45, 0x70,
+	0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x59, 0x65, 0x61, 0x72, 0x12, 0x36, 0x0a, 0x0b,
+	0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x15, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x57, 0x6f, 0x72,
+	0x6b, 0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16,
+	0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x57, 0x41, 0x54, 0x43, 0x48, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x54, 0x43, 0x48, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x03, 0x22, 0x50,
+	0x0a, 0x06, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x0a, 0x0a, 0x06, 0x57, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06,
+	0x53, 0x50, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x55, 0x4d, 0x4d,
+	0x45, 0x52, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x55, 0x54, 0x55, 0x4d, 0x4e, 0x10, 0x04,
+	0x22, 0xce, 0x01, 0x0a, 0x09, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x2b,
+	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x0e, 0x77,
+	0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x57,
+	0x6f, 0x72, 0x6b, 0x52, 0x0d, 0x77, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x12, 0x33, 0x0a, 0x0d, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x52, 0x0c, 0x77, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6b, 0x22, 0xbb, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x3e, 0x0a, 0x0b,
+	0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1c, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6b, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x52,
+	0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74,
+	0x22, 0x3d, 0x0a, 0x0b, 0x47, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x1b, 0x0a, 0x17, 0x47, 0x52, 0x41, 0x4e, 0x55, 0x4c, 0x41, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03,
+	0x44, 0x41, 0x59, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x02, 0x22,
+	0xae, 0x04, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x77, 0x6f,
+	0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x6f, 0x72,
+	0x6b, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x3e, 0x0a, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c,
+	0x6c, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
+	0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x2e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c,
+	0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x42, 0x0a, 0x10, 0x61, 0x6e, 0x69, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x2e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x61, 0x6e, 0x69, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x3a, 0x0a, 0x0c, 0x6d, 0x75,
+	0x73, 0x69, 0x63, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x2e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x6d, 0x75, 0x73, 0x69, 0x63,
+	0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f,
+	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x52,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x42, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f,
+	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x52,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
+	0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x4b, 0x0a, 0x06, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a,
+	0x12, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x41, 0x44, 0x10, 0x01, 0x12, 0x0b,
+	0x0a, 0x07, 0x41, 0x56, 0x45, 0x52, 0x41, 0x47, 0x45, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x47,
+	0x4f, 0x4f, 0x44, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x52, 0x45, 0x41, 0x54, 0x10, 0x04,
+	0x22, 0x83, 0x02, 0x0a, 0x06, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x77,
+	0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x6f,
+	0x72, 0x6b, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x5f, 0x73,
+	0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x11, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x53, 0x6f, 0x72, 0x74, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65,
+	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6c, 0x61,
+	0x73, 0x74, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xa6, 0x02, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x61, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x77,
+	0x6f, 0x72, 0x6b, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x70,
+	0x69, 0x73, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x70,
+	0x69, 0x73, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x72, 0x65, 0x62, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0xe4, 0x01, 0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x05,
+	0x64, 0x61, 0x69, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x05, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x06,
+	0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x12, 0x32,
+	0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x74, 0x68,
+	0x6c, 0x79, 0x1a, 0x42, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65,
+	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8f, 0x04, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x0e, 0x66, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x57, 0x6f,
+	0x72, 0x6b, 0x52, 0x0d, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x44, 0x61,
+	0x79, 0x73, 0x12, 0x41, 0x0a, 0x0c, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x77, 0x6f,
+	0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x52, 0x0b, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x12, 0x45, 0x0a, 0x0e, 0x77, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e,
+	0x67, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x52, 0x0d, 0x77,
+	0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x1a, 0x89, 0x02, 0x0a,
+	0x04, 0x57, 0x6f, 0x72, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65,
+	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64,
+	0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0e, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79,
+	0x12, 0x39, 0x0a, 0x0a, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xe9, 0x02, 0x0a, 0x0f, 0x53, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x3a, 0x0a, 0x07,
+	0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52,
+	0x07, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x1a, 0x99, 0x02, 0x0a, 0x06, 0x53, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06,
+	0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x61, 0x74, 0x63, 0x68, 0x69,
+	0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x77, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x64, 0x72, 0x6f, 0x70, 0x70,
+	0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x70, 0x69, 0x73, 0x6f,
+	0x64, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34,
+	0x0a, 0x16, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64,
+	0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14,
+	0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd1, 0x02, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x66, 0x66, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x14, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x66,
+	0x66, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77,
+	0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x54,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x74,
+	0x61, 0x66, 0x66, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5c,
+	0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x53, 0x54, 0x55, 0x44, 0x49, 0x4f, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x49, 0x52, 0x45,
+	0x43, 0x54, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x53,
+	0x45, 0x52, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x45, 0x52, 0x49, 0x45, 0x53, 0x5f, 0x43,
+	0x4f, 0x4d, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x22, 0x3a, 0x0a, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x45,
+	0x52, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x52, 0x47, 0x41, 0x4e, 0x49,
+	0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x22, 0xaa, 0x03, 0x0a, 0x0a, 0x53, 0x74, 0x61,
+	0x66, 0x66, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x74, 0x75, 0x64, 0x69,
+	0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x73, 0x74, 0x75, 0x64, 0x69, 0x6f, 0x73, 0x12, 0x38, 0x0a,
+	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x66,
+	0x66, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x73, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x72,
+	0x73, 0x12, 0x58, 0x0a, 0x1a, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x18, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x97, 0x01, 0x0a, 0x05,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x6f,
+	0x72, 0x6b, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x47, 0x6f, 0x6f, 0x64, 0x43, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x73, 0x2f, 0x61, 0x6e, 0x69, 0x6d, 0x65, 0x6b, 0x61, 0x69, 0x2f, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_resource_proto_rawDescOnce sync.Once
+	file_resource_proto_rawDescData = file_resource_proto_rawDesc
+)
+
+func file_resource_proto_rawDescGZIP() []byte {
+	file_resource_proto_rawDescOnce.Do(func() {
+		file_resource_proto_rawDescData = protoimpl.X.CompressGZIP(file_resource_proto_rawDescData)
+	})
+	return file_resource_proto_rawDescData
+}
+
+var file_resource_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_resource_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_resource_proto_goTypes = []interface{}{
+	(Work_Status)(0),               // 0: resource.Work.Status
+	(Work_Season)(0),               // 1: resource.Work.Season
+	(Streak_Granularity)(0),        // 2: resource.Streak.Granularity
+	(Review_Rating)(0),             // 3: resource.Review.Rating
+	(Staff_Role)(0),                // 4: resource.Staff.Role
+	(Staff_Type)(0),                // 5: resource.Staff.Type
+	(*Profile)(nil),                // 6: resource.Profile
+	(*Work)(nil),                   // 7: resource.Work
+	(*Dashboard)(nil),              // 8: resource.Dashboard
+	(*Streak)(nil),                 // 9: resource.Streak
+	(*Review)(nil),                 // 10: resource.Review
+	(*Record)(nil),                 // 11: resource.Record
+	(*Program)(nil),                // 12: resource.Program
+	(*Activity)(nil),               // 13: resource.Activity
+	(*CompletionStats)(nil),        // 14: resource.CompletionStats
+	(*SeasonBreakdown)(nil),        // 15: resource.SeasonBreakdown
+	(*Staff)(nil),                  // 16: resource.Staff
+	(*StaffStats)(nil),             // 17: resource.StaffStats
+	(*Activity_Count)(nil),         // 18: resource.Activity.Count
+	(*CompletionStats_Work)(nil),   // 19: resource.CompletionStats.Work
+	(*SeasonBreakdown_Season)(nil), // 20: resource.SeasonBreakdown.Season
+	(*StaffStats_Entry)(nil),       // 21: resource.StaffStats.Entry
+	(*timestamp.Timestamp)(nil),    // 22: google.protobuf.Timestamp
+}
+var file_resource_proto_depIdxs = []int32{
+	22, // 0: resource.Work.begin_time:type_name -> google.protobuf.Timestamp
+	22, // 1: resource.Work.finish_time:type_name -> google.protobuf.Timestamp
+	0,  // 2: resource.Work.status:type_name -> resource.Work.Status
+	1,  // 3: resource.Work.season_name:type_name -> resource.Work.Season
+	6,  // 4: resource.Dashboard.profile:type_name -> resource.Profile
+	7,  // 5: resource.Dashboard.watching_works:type_name -> resource.Work
+	7,  // 6: resource.Dashboard.watched_works:type_name -> resource.Work
+	9,  // 7: resource.Dashboard.streak:type_name -> resource.Streak
+	2,  // 8: resource.Streak.granularity:type_name -> resource.Streak.Granularity
+	3,  // 9: resource.Review.overall_rating:type_name -> resource.Review.Rating
+	3,  // 10: resource.Review.animation_rating:type_name -> resource.Review.Rating
+	3,  // 11: resource.Review.music_rating:type_name -> resource.Review.Rating
+	3,  // 12: resource.Review.story_rating:type_name -> resource.Review.Rating
+	3,  // 13: resource.Review.character_rating:type_name -> resource.Review.Rating
+	22, // 14: resource.Review.create_time:type_name -> google.protobuf.Timestamp
+	22, // 15: resource.Record.create_time:type_name -> google.protobuf.Timestamp
+	22, // 16: resource.Program.start_time:type_name -> google.protobuf.Timestamp
+	18, // 17: resource.Activity.daily:type_name -> resource.Activity.Count
+	18, // 18: resource.Activity.weekly:type_name -> resource.Activity.Count
+	18, // 19: resource.Activity.monthly:type_name -> resource.Activity.Count
+	19, // 20: resource.CompletionStats.finished_works:type_name -> resource.CompletionStats.Work
+	19, // 21: resource.CompletionStats.longest_work:type_name -> resource.CompletionStats.Work
+	19, // 22: resource.CompletionStats.watching_works:type_name -> resource.CompletionStats.Work
+	20, // 23: resource.SeasonBreakdown.seasons:type_name -> resource.SeasonBreakdown.Season
+	4,  // 24: resource.Staff.role:type_name -> resource.Staff.Role
+	5,  // 25: resource.Staff.type:type_name -> resource.Staff.Type
+	21, // 26: resource.StaffStats.studios:type_name -> resource.StaffStats.Entry
+	21, // 27: resource.StaffStats.directors:type_name -> resource.StaffStats.Entry
+	21, // 28: resource.StaffStats.composers:type_name -> resource.StaffStats.Entry
+	21, // 29: resource.StaffStats.series_composition_writers:type_name -> resource.StaffStats.Entry
+	22, // 30: resource.CompletionStats.Work.begin_time:type_name -> google.protobuf.Timestamp
+	22, // 31: resource.CompletionStats.Work.finish_time:type_name -> google.protobuf.Timestamp
+	1,  // 32: resource.SeasonBreakdown.Season.season:type_name -> resource.Work.Season
+	5,  // 33: resource.StaffStats.Entry.type:type_name -> resource.Staff.Type
+	34, // [34:34] is the sub-list for method output_type
+	34, // [34:34] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
+}
+
+func init() { file_resource_proto_init() }
+func file_resource_proto_init() {
+	if File_resource_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_resource_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Profile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_resource_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Work); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_resource_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Dashboard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_resource_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Streak); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_resource_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Review); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_resource_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Record); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_resource_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Program); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_resource_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Activity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_resource_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompletionStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_resource_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SeasonBreakdown); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_resource_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Staff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_resource_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StaffStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_resource_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Activity_Count); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_resource_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompletionStats_Work); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_resource_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SeasonBreakdown_Season); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_resource_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StaffStats_Entry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -510,8 +2350,8 @@ func file_resource_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_resource_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   3,
+			NumEnums:      6,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -26,6 +26,7 @@ var failureCodeToGRPCCode = map[failure.Code]codes.Code{
 	errors.Canceled:         codes.Canceled,
 	errors.DeadlineExceeded: codes.DeadlineExceeded,
 	errors.Internal:         codes.Internal,
+	errors.NotFound:         codes.NotFound,
 }
 
 func convertErrorToCodeUnaryServerInterceptor(
@@ -21,6 +21,8 @@ func New(
 	logger *zap.Logger,
 	statisticsService api.StatisticsServer,
 	slackService http.Handler,
+	slackInteractionHandler http.Handler,
+	imageHandler http.Handler,
 	fs http.FileSystem,
 	enableCORS bool,
 ) http.Handler {
@@ -30,7 +32,19 @@ func New(
 	mux := http.NewServeMux()
 	mux.Handle(endpoint(srv.GetDashboardWithName(appendGRPCStatusToHeader, ints...)))
 	mux.Handle(endpoint(srv.ListWorksWithName(appendGRPCStatusToHeader, ints...)))
+	mux.Handle(endpoint(srv.ListReviewsWithName(appendGRPCStatusToHeader, ints...)))
+	mux.Handle(endpoint(srv.ListUpcomingProgramsWithName(appendGRPCStatusToHeader, ints...)))
+	mux.Handle(endpoint(srv.GetActivityWithName(appendGRPCStatusToHeader, ints...)))
+	mux.Handle(endpoint(srv.GetCompletionStatsWithName(appendGRPCStatusToHeader, ints...)))
+	mux.Handle(endpoint(srv.GetSeasonBreakdownWithName(appendGRPCStatusToHeader, ints...)))
+	mux.Handle(endpoint(srv.GetStaffStatsWithName(appendGRPCStatusToHeader, ints...)))
 	mux.Handle("/slack", slackService)
+	if slackInteractionHandler != nil {
+		mux.Handle("/slack/interactive", slackInteractionHandler)
+	}
+	if imageHandler != nil {
+		mux.Handle("/images/", imageHandler)
+	}
 	if fs != nil {
 		mux.Handle("/", http.FileServer(fs))
 	}
@@ -0,0 +1,298 @@
+package slack
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/GoodCodingFriends/animekai/errors"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/morikuni/failure"
+	"github.com/slack-go/slack"
+	"go.uber.org/zap"
+)
+
+// runFunc runs a subcommand with positional arguments. Flags are already parsed.
+type runFunc func(ctx context.Context, cmd *slack.SlashCommand, args []string) (*slack.Msg, error)
+
+// command is a subcommand of /animekai.
+type command struct {
+	name string
+	// args describes positional arguments in the usage.
+	args    string
+	summary string
+	// minArgs is the number of required positional arguments. The usage is shown if they are not passed.
+	minArgs int
+	// setup registers flags of the command to fs and returns the function which runs the command.
+	// setup is called for each invocation, so variables bound to flags are not shared between invocations.
+	setup func(fs *flag.FlagSet) runFunc
+}
+
+// router dispatches slash commands to registered subcommands.
+type router struct {
+	logger   *zap.Logger
+	commands map[string]*command
+	// names keeps the registration order for help.
+	names []string
+}
+
+func newRouter(logger *zap.Logger, cmds ...*command) *router {
+	r := &router{logger: logger, commands: map[string]*command{}}
+	r.register(&command{
+		name:    "help",
+		args:    "[<command>]",
+		summary: "show usages of commands",
+		setup: func(*flag.FlagSet) runFunc {
+			return func(_ context.Context, _ *slack.SlashCommand, args []string) (*slack.Msg, error) {
+				if len(args) == 0 {
+					return ephemeral(r.help()), nil
+				}
+				c, ok := r.commands[args[0]]
+				if !ok {
+					return nil, failure.New(errors.InvalidArgument, failure.Messagef("unknown command %q", args[0]))
+				}
+				return ephemeral(c.help()), nil
+			}
+		},
+	})
+	for _, c := range cmds {
+		r.register(c)
+	}
+	return r
+}
+
+func (r *router) register(c *command) {
+	if _, ok := r.commands[c.name]; ok {
+		panic(fmt.Sprintf("command %s is already registered", c.name))
+	}
+	r.commands[c.name] = c
+	r.names = append(r.names, c.name)
+}
+
+// route runs the subcommand specified by cmd.Text. Empty text shows the help.
+func (r *router) route(cmd *slack.SlashCommand) (*slack.Msg, error) {
+	args, err := tokenize(cmd.Text)
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+	if len(args) == 0 {
+		return ephemeral(r.help()), nil
+	}
+
+	c, ok := r.commands[args[0]]
+	if !ok {
+		return nil, failure.New(
+			errors.InvalidArgument,
+			failure.Messagef("unknown command %q", args[0]),
+			failure.Message("see `/animekai help`"),
+		)
+	}
+	r.logger.Info(c.name)
+
+	if wantsHelp(args[1:]) {
+		return ephemeral(c.help()), nil
+	}
+
+	msg, err := c.run(ctxzap.ToContext(context.Background(), r.logger.Named(c.name)), cmd, args[1:])
+	if err != nil {
+		if failure.Is(err, errors.InvalidArgument) {
+			return nil, failure.Wrap(err, failure.Message(c.usage()))
+		}
+		return nil, failure.Wrap(err)
+	}
+	return msg, nil
+}
+
+func (r *router) help() string {
+	text := "usage: /animekai <command> [<args>]\ncommands:\n"
+	for _, name := range r.names {
+		text += fmt.Sprintf("  %s: %s\n", name, r.commands[name].summary)
+	}
+	return text + "run `/animekai help <command>` for details."
+}
+
+func (c *command) run(ctx context.Context, cmd *slack.SlashCommand, args []string) (*slack.Msg, error) {
+	fs := c.flagSet()
+	run := c.setup(fs)
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+	if len(args) < c.minArgs {
+		return ephemeral(c.help()), nil
+	}
+	return run(ctx, cmd, args)
+}
+
+func (c *command) flagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet(c.name, flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	return fs
+}
+
+// usage returns the usage line generated from flags and args of c.
+func (c *command) usage() string {
+	fs := c.flagSet()
+	c.setup(fs)
+
+	usage := "usage: /animekai " + c.name
+	fs.VisitAll(func(f *flag.Flag) {
+		if isBoolFlag(f) {
+			usage += fmt.Sprintf(" [--%s]", f.Name)
+			return
+		}
+		name, _ := flag.UnquoteUsage(f)
+		usage += fmt.Sprintf(" [--%s <%s>]", f.Name, name)
+	})
+	if c.args != "" {
+		usage += " " + c.args
+	}
+	return usage
+}
+
+func (c *command) help() string {
+	text := c.usage() + "\n" + c.summary
+
+	fs := c.flagSet()
+	c.setup(fs)
+	fs.VisitAll(func(f *flag.Flag) {
+		_, usage := flag.UnquoteUsage(f)
+		text += fmt.Sprintf("\n  --%s: %s", f.Name, usage)
+	})
+	return text
+}
+
+// wantsHelp reports whether args contain -h or --help before "--".
+func wantsHelp(args []string) bool {
+	for _, arg := range args {
+		switch arg {
+		case "--":
+			return false
+		case "-h", "--help":
+			return true
+		}
+	}
+	return false
+}
+
+// parseFlags parses flags in args like fs.Parse, but flags may follow positional arguments.
+// Only arguments prefixed with "--" are regarded as flags so that arguments such as "-<title>" are passed through.
+// "--" and arguments following it are returned as they are because some commands take free text after "--".
+func parseFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	var rest []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			return append(rest, args[i:]...), nil
+		}
+		if !strings.HasPrefix(arg, "--") {
+			rest = append(rest, arg)
+			continue
+		}
+
+		name, value := strings.TrimPrefix(arg, "--"), ""
+		hasValue := false
+		if idx := strings.Index(name, "="); idx != -1 {
+			name, value, hasValue = name[:idx], name[idx+1:], true
+		}
+		f := fs.Lookup(name)
+		if f == nil {
+			return nil, failure.New(errors.InvalidArgument, failure.Messagef("unknown flag --%s", name))
+		}
+		if !hasValue {
+			if isBoolFlag(f) {
+				value = "true"
+			} else {
+				if i+1 == len(args) {
+					return nil, failure.New(errors.InvalidArgument, failure.Messagef("--%s requires a value", name))
+				}
+				i++
+				value = args[i]
+			}
+		}
+		if err := fs.Set(name, value); err != nil {
+			return nil, failure.Translate(err, errors.InvalidArgument, failure.Messagef("invalid value %q for --%s", value, name))
+		}
+	}
+	return rest, nil
+}
+
+func isBoolFlag(f *flag.Flag) bool {
+	b, ok := f.Value.(interface{ IsBoolFlag() bool })
+	return ok && b.IsBoolFlag()
+}
+
+// closingQuotes maps opening quotes to closing ones.
+// Slack clients may replace quotes with smart quotes, so they are accepted as well.
+var closingQuotes = map[rune]rune{
+	'"':  '"',
+	'\'': '\'',
+	'“':  '”',
+	'‘':  '’',
+}
+
+// tokenize splits s into arguments like a shell.
+// Arguments are separated by spaces, tabs or newlines, and quotes or backslashes make them contain those characters.
+// Full-width spaces don't separate arguments because they are common in Japanese titles.
+// The text following "--" is returned as a single argument without any processing because it is free text such as
+// comments, which may contain apostrophes.
+func tokenize(s string) ([]string, error) {
+	var (
+		tokens  []string
+		b       strings.Builder
+		inToken bool
+		escaped bool
+		// quoted reports whether the current token contains quoted or escaped characters.
+		quoted bool
+		// quote is the closing quote of the current quoted part, or 0 if it is not quoted.
+		quote rune
+	)
+	for i, r := range s {
+		switch {
+		case escaped:
+			b.WriteRune(r)
+			escaped = false
+		case quote != 0 && r == quote:
+			quote = 0
+		case r == '\\' && quote != '\'' && quote != '’':
+			escaped = true
+			inToken = true
+			quoted = true
+		case quote != 0:
+			b.WriteRune(r)
+		case closingQuotes[r] != 0:
+			quote = closingQuotes[r]
+			inToken = true
+			quoted = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if !inToken {
+				continue
+			}
+			tokens = append(tokens, b.String())
+			if b.String() == "--" && !quoted {
+				if rest := strings.TrimSpace(s[i+1:]); rest != "" {
+					tokens = append(tokens, rest)
+				}
+				return tokens, nil
+			}
+			b.Reset()
+			inToken = false
+			quoted = false
+		default:
+			b.WriteRune(r)
+			inToken = true
+		}
+	}
+	if quote != 0 {
+		return nil, failure.New(errors.InvalidArgument, failure.Messagef("missing closing quote %q", quote))
+	}
+	if escaped {
+		return nil, failure.New(errors.InvalidArgument, failure.Message("trailing backslash"))
+	}
+	if inToken {
+		tokens = append(tokens, b.String())
+	}
+	return tokens, nil
+}
@@ -0,0 +1,140 @@
+package slack
+
+import (
+	"context"
+	"flag"
+	"strings"
+	"testing"
+
+	"github.com/GoodCodingFriends/animekai/errors"
+	"github.com/google/go-cmp/cmp"
+	"github.com/morikuni/failure"
+	"github.com/slack-go/slack"
+	"go.uber.org/zap"
+)
+
+func TestTokenize(t *testing.T) {
+	cases := map[string]struct {
+		s       string
+		want    []string
+		wantErr bool
+	}{
+		"empty":            {s: "", want: nil},
+		"spaces":           {s: "  start\tfoo  bar\n", want: []string{"start", "foo", "bar"}},
+		"double quotes":    {s: `add "non non biyori"`, want: []string{"add", "non non biyori"}},
+		"single quotes":    {s: `add 'a "b" c'`, want: []string{"add", `a "b" c`}},
+		"smart quotes":     {s: "add “non non” biyori", want: []string{"add", "non non", "biyori"}},
+		"escape":           {s: `add non\ non`, want: []string{"add", "non non"}},
+		"empty quotes":     {s: `add ""`, want: []string{"add", ""}},
+		"full-width space": {s: "add のんのん　びより", want: []string{"add", "のんのん　びより"}},
+		"free text":        {s: "start foo -- it's  \"great\"", want: []string{"start", "foo", "--", `it's  "great"`}},
+		"quoted --":        {s: `start "--" it's`, wantErr: true},
+		"unclosed quote":   {s: `add "non`, wantErr: true},
+		"trailing escape":  {s: `add non\`, wantErr: true},
+	}
+
+	for name, c := range cases {
+		c := c
+		t.Run(name, func(t *testing.T) {
+			got, err := tokenize(c.s)
+			if c.wantErr {
+				if !failure.Is(err, errors.InvalidArgument) {
+					t.Fatalf("expected InvalidArgument, but got '%v'", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(c.want, got); diff != "" {
+				t.Errorf("-want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRouter(t *testing.T) {
+	var got []string
+	r := newRouter(zap.NewNop(), &command{
+		name:    "echo",
+		args:    "<words>...",
+		summary: "echo words",
+		minArgs: 1,
+		setup: func(fs *flag.FlagSet) runFunc {
+			upper := fs.Bool("upper", false, "print in upper case")
+			sep := fs.String("sep", " ", "join words with `separator`")
+			return func(_ context.Context, _ *slack.SlashCommand, args []string) (*slack.Msg, error) {
+				got = args
+				text := strings.Join(args, *sep)
+				if *upper {
+					text = strings.ToUpper(text)
+				}
+				return inChannel(text), nil
+			}
+		},
+	})
+
+	cases := map[string]struct {
+		text     string
+		wantText string
+		wantArgs []string
+		wantCode failure.StringCode
+	}{
+		"empty": {
+			text:     "",
+			wantText: "echo: echo words",
+		},
+		"help": {
+			text:     "help echo",
+			wantText: "usage: /animekai echo [--sep <separator>] [--upper] <words>...",
+		},
+		"-h": {
+			text:     "echo foo -h",
+			wantText: "--upper: print in upper case",
+		},
+		"flags after args": {
+			text:     `echo foo "bar baz" --upper --sep=, -- --qux quux`,
+			wantText: "FOO,BAR BAZ,--,--QUX QUUX",
+			wantArgs: []string{"foo", "bar baz", "--", "--qux quux"},
+		},
+		"missing args": {
+			text:     "echo --upper",
+			wantText: "usage: /animekai echo",
+		},
+		"unknown command": {
+			text:     "ech",
+			wantCode: errors.InvalidArgument,
+		},
+		"unknown flag": {
+			text:     "echo --lower foo",
+			wantCode: errors.InvalidArgument,
+		},
+		"missing flag value": {
+			text:     "echo foo --sep",
+			wantCode: errors.InvalidArgument,
+		},
+	}
+
+	for name, c := range cases {
+		c := c
+		t.Run(name, func(t *testing.T) {
+			got = nil
+			msg, err := r.route(&slack.SlashCommand{Text: c.text})
+			if c.wantCode != "" {
+				if !failure.Is(err, c.wantCode) {
+					t.Fatalf("expected %s, but got '%v'", c.wantCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(msg.Text, c.wantText) {
+				t.Errorf("%q should contain %q", msg.Text, c.wantText)
+			}
+			if diff := cmp.Diff(c.wantArgs, got); diff != "" {
+				t.Errorf("-want, +got\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/GoodCodingFriends/animekai/annict"
+	"github.com/GoodCodingFriends/animekai/resource"
+	"github.com/morikuni/failure"
+	"github.com/slack-go/slack"
+)
+
+// maxSectionTextSize is the max length of texts in section blocks.
+const maxSectionTextSize = 3000
+
+// Digest posts digests of records to Slack.
+type Digest interface {
+	// Post posts the digest of records created in [since, until) to the incoming webhook.
+	Post(ctx context.Context, since, until time.Time) error
+}
+
+type digest struct {
+	webhookURL string
+
+	annict annict.Service
+}
+
+// NewDigest returns a Digest which posts digests to webhookURL.
+func NewDigest(webhookURL string, annictService annict.Service) Digest {
+	return &digest{webhookURL: webhookURL, annict: annictService}
+}
+
+func (d *digest) Post(ctx context.Context, since, until time.Time) error {
+	records, err := d.annict.ListRecords(ctx, since, until)
+	if err != nil {
+		return failure.Wrap(err)
+	}
+	if err := postMessage(d.webhookURL, digestMessage(since, until, records)); err != nil {
+		return failure.Wrap(err)
+	}
+	return nil
+}
+
+// digestMessage builds the digest of records. records must be in chronological order.
+// It consists of the number of recorded episodes per work, works started in the period and works finished in the
+// period. Dates are shown in the location of since.
+func digestMessage(since, until time.Time, records []*resource.Record) *slack.Msg {
+	works, started, finished := summarizeRecords(records)
+
+	period := fmt.Sprintf(
+		"%s - %s",
+		since.Format("2006/01/02"),
+		until.Add(-time.Nanosecond).In(since.Location()).Format("2006/01/02"),
+	)
+	summary := fmt.Sprintf(
+		"%d episodes recorded, %d works started, %d works finished",
+		len(records), len(started), len(finished),
+	)
+	if len(records) == 0 {
+		summary = "no episodes recorded"
+	}
+
+	blocks := []slack.Block{
+		slack.NewSectionBlock(markdown(fmt.Sprintf("*animekai digest* (%s)\n%s", period, summary)), nil, nil),
+	}
+	if len(works) != 0 {
+		text := "*Watched*"
+		for _, w := range works {
+			text += fmt.Sprintf("\n• %s (%d)", escape(w.title), w.n)
+		}
+		blocks = append(blocks, slack.NewDividerBlock(), slack.NewSectionBlock(markdown(text), nil, nil))
+	}
+	for _, s := range []struct {
+		heading string
+		titles  []string
+	}{
+		{heading: "*Started*", titles: started},
+		{heading: "*Finished*", titles: finished},
+	} {
+		if len(s.titles) == 0 {
+			continue
+		}
+		text := s.heading
+		for _, t := range s.titles {
+			text += "\n• " + escape(t)
+		}
+		blocks = append(blocks, slack.NewSectionBlock(markdown(text), nil, nil))
+	}
+
+	return &slack.Msg{
+		Text:   fmt.Sprintf("animekai digest (%s): %s", period, summary),
+		Blocks: slack.Blocks{BlockSet: blocks},
+	}
+}
+
+// workEpisodes is the number of recorded episodes of a work.
+type workEpisodes struct {
+	title string
+	n     int
+}
+
+// summarizeRecords counts recorded episodes per work in the order of the first record, and lists titles of works
+// started and finished by records.
+func summarizeRecords(records []*resource.Record) (works []*workEpisodes, started, finished []string) {
+	byWork := map[int32]*workEpisodes{}
+	for _, r := range records {
+		w, ok := byWork[r.WorkId]
+		if !ok {
+			w = &workEpisodes{title: r.WorkTitle}
+			byWork[r.WorkId] = w
+			works = append(works, w)
+		}
+		w.n++
+		if r.FirstRecord {
+			started = append(started, r.WorkTitle)
+		}
+		if r.LastEpisode {
+			finished = append(finished, r.WorkTitle)
+		}
+	}
+	return works, started, finished
+}
+
+// escape escapes control characters of Slack's mrkdwn.
+var escape = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace
+
+func markdown(text string) *slack.TextBlockObject {
+	return slack.NewTextBlockObject(slack.MarkdownType, truncate(text, maxSectionTextSize), false, false)
+}
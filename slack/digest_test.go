@@ -0,0 +1,48 @@
+package slack
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GoodCodingFriends/animekai/annict"
+	"github.com/GoodCodingFriends/animekai/resource"
+	"github.com/slack-go/slack"
+)
+
+func TestDigestMessage(t *testing.T) {
+	since := time.Date(2020, 7, 10, 21, 0, 0, 0, annict.JST)
+	until := since.AddDate(0, 0, 7)
+
+	records := []*resource.Record{
+		{WorkId: 1, WorkTitle: "のんのんびより", EpisodeSortNumber: 11, LastEpisode: false},
+		{WorkId: 2, WorkTitle: "ゆるキャン△", EpisodeSortNumber: 1, FirstRecord: true},
+		{WorkId: 1, WorkTitle: "のんのんびより", EpisodeSortNumber: 12, LastEpisode: true},
+	}
+
+	msg := digestMessage(since, until, records)
+	if want := "animekai digest (2020/07/10 - 2020/07/17): 3 episodes recorded, 1 works started, 1 works finished"; msg.Text != want {
+		t.Errorf("want %q, but got %q", want, msg.Text)
+	}
+
+	var texts []string
+	for _, b := range msg.Blocks.BlockSet {
+		if s, ok := b.(*slack.SectionBlock); ok {
+			texts = append(texts, s.Text.Text)
+		}
+	}
+	got := strings.Join(texts, "\n")
+	for _, want := range []string{
+		"• のんのんびより (2)\n• ゆるキャン△ (1)",
+		"*Started*\n• ゆるキャン△",
+		"*Finished*\n• のんのんびより",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("%q should contain %q", got, want)
+		}
+	}
+
+	if msg := digestMessage(since, until, nil); len(msg.Blocks.BlockSet) != 1 {
+		t.Errorf("only the summary should be posted if there are no records, but got %d blocks", len(msg.Blocks.BlockSet))
+	}
+}
@@ -0,0 +1,275 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/GoodCodingFriends/animekai/annict"
+	"github.com/GoodCodingFriends/animekai/errors"
+	"github.com/GoodCodingFriends/animekai/resource"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/morikuni/failure"
+	"github.com/slack-go/slack"
+	"go.uber.org/zap"
+)
+
+const (
+	startCallbackID   = "start"
+	worksBlockIDFmt   = "works_%d"
+	worksActionID     = "works"
+	maxCheckboxes     = 10
+	maxOptionTextSize = 75
+)
+
+// interaction is the subset of interaction payloads used by interactionHandler.
+// slack.InteractionCallback is not used because it fails to decode views which contain checkboxes.
+type interaction struct {
+	Type slack.InteractionType `json:"type"`
+	User struct {
+		ID string `json:"id"`
+	} `json:"user"`
+	View struct {
+		CallbackID string `json:"callback_id"`
+		// PrivateMetadata is the ID of the channel where the modal is opened.
+		PrivateMetadata string           `json:"private_metadata"`
+		State           *slack.ViewState `json:"state"`
+	} `json:"view"`
+}
+
+type interactionHandler struct {
+	logger        *zap.Logger
+	signingSecret string
+	webhookURL    string
+	// client is nil if no bot token is specified.
+	client *slack.Client
+
+	annict annict.Service
+}
+
+// NewInteractionHandler returns a handler for interactive components such as modals opened by slash commands.
+// Errors are posted as ephemeral messages to the submitting user with botToken.
+func NewInteractionHandler(logger *zap.Logger, signingSecret, webhookURL, botToken string, annictService annict.Service) http.Handler {
+	h := &interactionHandler{
+		logger:        logger,
+		signingSecret: signingSecret,
+		webhookURL:    webhookURL,
+		annict:        annictService,
+	}
+	if botToken != "" {
+		h.client = slack.New(botToken)
+	}
+	return h
+}
+
+func (h *interactionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.logger.Warn("non-POST request")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	verifier, err := slack.NewSecretsVerifier(r.Header, h.signingSecret)
+	if err != nil {
+		h.logger.Warn("failed to create a new secrets verifier", zap.Error(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	r.Body = ioutil.NopCloser(io.TeeReader(r.Body, &verifier))
+
+	if err := r.ParseForm(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		h.logger.Warn("failed to parse form", zap.Error(err))
+		return
+	}
+
+	if err := verifier.Ensure(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		h.logger.Warn("failed to authenticate request", zap.Error(err))
+		return
+	}
+
+	var callback interaction
+	if err := json.Unmarshal([]byte(r.PostForm.Get("payload")), &callback); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		h.logger.Warn("failed to parse interaction payload", zap.Error(err))
+		return
+	}
+
+	if callback.Type != slack.InteractionTypeViewSubmission || callback.View.CallbackID != startCallbackID {
+		h.logger.Info("ignore unknown interaction", zap.String("type", string(callback.Type)), zap.String("callback_id", callback.View.CallbackID))
+		return
+	}
+
+	workIDs := selectedWorkIDs(callback.View.State)
+	if len(workIDs) == 0 {
+		h.respond(w, slack.NewErrorsViewSubmissionResponse(map[string]string{
+			fmt.Sprintf(worksBlockIDFmt, 0): "select at least one work",
+		}))
+		return
+	}
+
+	// The modal is closed by the empty response, and the result is posted later.
+	h.submitStart(workIDs, callback.View.PrivateMetadata, callback.User.ID) // submitStart runs asynchronously.
+}
+
+func (h *interactionHandler) respond(w http.ResponseWriter, res *slack.ViewSubmissionResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		h.logger.Warn("failed to encode response body", zap.Error(err))
+	}
+}
+
+func (h *interactionHandler) submitStart(workIDs []string, channelID, userID string) {
+	go func() {
+		logger := h.logger.Named("start")
+		ctx := ctxzap.ToContext(context.Background(), logger)
+		episodes, err := start(ctx, h.annict, []annict.RecordOption{annict.IncludeWorks(workIDs...)})
+		if err != nil {
+			logger.Error("failed to call start", zap.Error(err))
+			h.postEphemeral(ctx, channelID, userID, errorMessage(err))
+			return
+		}
+		if len(episodes) == 0 {
+			return
+		}
+		// View submissions have no response URLs, so the result is posted to the webhook.
+		reply(logger, "", h.webhookURL, inChannel(formatEpisodes(episodes)))
+	}()
+}
+
+// postEphemeral posts text to the user in the channel. Errors must not be posted to the webhook because the webhook
+// makes them visible to everyone in the channel, so text is only logged if it can't be posted.
+func (h *interactionHandler) postEphemeral(ctx context.Context, channelID, userID, text string) {
+	logger := ctxzap.Extract(ctx)
+	if h.client == nil || channelID == "" || userID == "" {
+		logger.Warn("no channel to post the ephemeral message", zap.String("text", text))
+		return
+	}
+	if _, err := h.client.PostEphemeralContext(ctx, channelID, userID, slack.MsgOptionText(text, false)); err != nil {
+		logger.Error("failed to post the ephemeral message", zap.Error(err), zap.String("text", text))
+	}
+}
+
+// openStartModal opens the modal which lists the next episodes of watching works with checkboxes.
+// A loading modal is opened first because listing episodes may take longer than the trigger ID is valid, and then
+// it is updated to the list. Errors on listing episodes are shown in the modal.
+// channelID is kept in the modal so that errors on submission are posted to the channel.
+func openStartModal(ctx context.Context, client *slack.Client, annictService annict.Service, triggerID, channelID string) error {
+	res, err := client.OpenViewContext(ctx, triggerID, messageModal("Loading episodes..."))
+	if err != nil {
+		return failure.Translate(err, errors.Internal)
+	}
+
+	var modal slack.ModalViewRequest
+	episodes, err := annictService.CreateNextEpisodeRecords(ctx, annict.DryRun())
+	switch {
+	case err != nil:
+		ctxzap.Extract(ctx).Error("failed to list episodes to be recorded", zap.Error(err))
+		modal = messageModal(errorMessage(err))
+	case len(episodes) == 0:
+		modal = messageModal("no episodes to be recorded")
+	default:
+		modal = startModal(episodes)
+		modal.PrivateMetadata = channelID
+	}
+
+	// The hash prevents the update from overwriting the modal if it has been updated since it was opened.
+	if _, err := client.UpdateViewContext(ctx, modal, "", res.Hash, res.ID); err != nil {
+		return failure.Translate(err, errors.Internal)
+	}
+	return nil
+}
+
+// messageModal builds a modal which only shows text.
+func messageModal(text string) slack.ModalViewRequest {
+	return slack.ModalViewRequest{
+		Type:  slack.VTModal,
+		Title: slack.NewTextBlockObject(slack.PlainTextType, "animekai", false, false),
+		Close: slack.NewTextBlockObject(slack.PlainTextType, "Close", false, false),
+		Blocks: slack.Blocks{BlockSet: []slack.Block{
+			slack.NewSectionBlock(slack.NewTextBlockObject(slack.PlainTextType, text, false, false), nil, nil),
+		}},
+	}
+}
+
+// startModal builds the modal for start. All works are checked initially.
+// Works are split into several checkbox groups because a checkbox group accepts at most 10 options.
+func startModal(episodes []*resource.Episode) slack.ModalViewRequest {
+	var (
+		options []*slack.OptionBlockObject
+		seen    = map[int32]struct{}{}
+	)
+	for _, e := range episodes {
+		if _, ok := seen[e.WorkID]; ok {
+			continue
+		}
+		seen[e.WorkID] = struct{}{}
+		text := truncate(fmt.Sprintf("%s %s %s", e.WorkTitle, e.NumberText, e.Title), maxOptionTextSize)
+		options = append(options, slack.NewOptionBlockObject(
+			strconv.Itoa(int(e.WorkID)),
+			slack.NewTextBlockObject(slack.PlainTextType, text, false, false),
+		))
+	}
+
+	var blocks []slack.Block
+	for i := 0; i*maxCheckboxes < len(options); i++ {
+		end := (i + 1) * maxCheckboxes
+		if end > len(options) {
+			end = len(options)
+		}
+		element := slack.NewCheckboxGroupsBlockElement(worksActionID, options[i*maxCheckboxes:end]...)
+		element.InitialOptions = element.Options
+		label := "Works to be recorded"
+		if i != 0 {
+			label = "More works"
+		}
+		block := slack.NewInputBlock(
+			fmt.Sprintf(worksBlockIDFmt, i),
+			slack.NewTextBlockObject(slack.PlainTextType, label, false, false),
+			element,
+		)
+		block.Optional = true
+		blocks = append(blocks, block)
+	}
+
+	return slack.ModalViewRequest{
+		Type:       slack.VTModal,
+		CallbackID: startCallbackID,
+		Title:      slack.NewTextBlockObject(slack.PlainTextType, "animekai", false, false),
+		Submit:     slack.NewTextBlockObject(slack.PlainTextType, "Record", false, false),
+		Close:      slack.NewTextBlockObject(slack.PlainTextType, "Cancel", false, false),
+		Blocks:     slack.Blocks{BlockSet: blocks},
+	}
+}
+
+// selectedWorkIDs returns IDs of works checked in the submitted start modal.
+func selectedWorkIDs(state *slack.ViewState) []string {
+	if state == nil {
+		return nil
+	}
+	var ids []string
+	for blockID, actions := range state.Values {
+		if !strings.HasPrefix(blockID, strings.TrimSuffix(worksBlockIDFmt, "%d")) {
+			continue
+		}
+		for _, o := range actions[worksActionID].SelectedOptions {
+			ids = append(ids, o.Value)
+		}
+	}
+	return ids
+}
+
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	r := []rune(s)
+	return string(r[:n-1]) + "…"
+}
@@ -0,0 +1,247 @@
+package slack
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GoodCodingFriends/animekai/annict"
+	"github.com/GoodCodingFriends/animekai/errors"
+	"github.com/GoodCodingFriends/animekai/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/morikuni/failure"
+	"github.com/slack-go/slack"
+	"go.uber.org/zap"
+)
+
+const testSigningSecret = "secret"
+
+type fakeAnnictService struct {
+	annict.Service
+	called   chan struct{}
+	episodes []*resource.Episode
+	err      error
+}
+
+func (s *fakeAnnictService) CreateNextEpisodeRecords(context.Context, ...annict.RecordOption) ([]*resource.Episode, error) {
+	close(s.called)
+	return s.episodes, s.err
+}
+
+func TestInteractionHandler(t *testing.T) {
+	episodes := make([]*resource.Episode, 12)
+	for i := range episodes {
+		episodes[i] = &resource.Episode{WorkID: int32(i + 1), WorkTitle: fmt.Sprintf("work %d", i+1)}
+	}
+	modal := startModal(episodes)
+	if n := len(modal.Blocks.BlockSet); n != 2 {
+		t.Fatalf("12 works should be split into 2 blocks, but got %d blocks", n)
+	}
+
+	cases := map[string]struct {
+		selected  map[string][]string
+		wantIDs   []string
+		wantStart bool
+	}{
+		"selected": {
+			selected:  map[string][]string{"works_0": {"1", "3"}, "works_1": {"12"}},
+			wantIDs:   []string{"1", "12", "3"},
+			wantStart: true,
+		},
+		"nothing selected": {
+			selected: map[string][]string{"works_0": {}},
+		},
+	}
+
+	for name, c := range cases {
+		c := c
+		t.Run(name, func(t *testing.T) {
+			values := map[string]map[string]slack.BlockAction{}
+			for blockID, ids := range c.selected {
+				var options []slack.OptionBlockObject
+				for _, id := range ids {
+					options = append(options, slack.OptionBlockObject{Value: id})
+				}
+				values[blockID] = map[string]slack.BlockAction{worksActionID: {SelectedOptions: options}}
+			}
+			callback := slack.InteractionCallback{
+				Type: slack.InteractionTypeViewSubmission,
+				View: slack.View{
+					Type:       slack.VTModal,
+					CallbackID: modal.CallbackID,
+					Blocks:     modal.Blocks,
+					State:      &slack.ViewState{Values: values},
+				},
+			}
+			b, err := json.Marshal(callback)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Decode the payload in the same way as the handler.
+			var decoded interaction
+			if err := json.Unmarshal(b, &decoded); err != nil {
+				t.Fatal(err)
+			}
+			ids := selectedWorkIDs(decoded.View.State)
+			sort.Strings(ids)
+			if diff := cmp.Diff(c.wantIDs, ids); diff != "" {
+				t.Errorf("-want, +got\n%s", diff)
+			}
+
+			svc := &fakeAnnictService{called: make(chan struct{})}
+			h := NewInteractionHandler(zap.NewNop(), testSigningSecret, "", "", svc)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, newSignedRequest(t, url.Values{"payload": {string(b)}}.Encode()))
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected status is 200, but got %d", w.Code)
+			}
+			select {
+			case <-svc.called:
+				if !c.wantStart {
+					t.Error("start should not be called")
+				}
+			case <-time.After(time.Second):
+				if c.wantStart {
+					t.Error("start should be called")
+				}
+			}
+			if !c.wantStart && !strings.Contains(w.Body.String(), `"response_action":"errors"`) {
+				t.Errorf("errors should be returned, but got %s", w.Body.String())
+			}
+		})
+	}
+}
+
+func TestInteractionHandlerPostsErrorsAsEphemeral(t *testing.T) {
+	posted := make(chan url.Values, 1)
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("failed to parse form: '%s'", err)
+		}
+		if r.URL.Path == "/chat.postEphemeral" {
+			posted <- r.PostForm
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := io.WriteString(w, `{"ok": true}`); err != nil {
+			t.Errorf("WriteString should not return an error, but got '%s'", err)
+		}
+	}))
+	t.Cleanup(api.Close)
+	// The webhook must not be used because it posts errors to everyone in the channel.
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("the error should not be posted to the webhook")
+	}))
+	t.Cleanup(webhook.Close)
+
+	svc := &fakeAnnictService{called: make(chan struct{}), err: failure.New(errors.NotFound)}
+	h := NewInteractionHandler(zap.NewNop(), testSigningSecret, webhook.URL, "token", svc).(*interactionHandler)
+	h.client = slack.New("token", slack.OptionAPIURL(api.URL+"/"))
+
+	payload := `{"type": "view_submission", "user": {"id": "U1"}, "view": {"callback_id": "start", "private_metadata": "C1",
+		"state": {"values": {"works_0": {"works": {"selected_options": [{"value": "1"}]}}}}}}`
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newSignedRequest(t, url.Values{"payload": {payload}}.Encode()))
+
+	select {
+	case v := <-posted:
+		if v.Get("channel") != "C1" || v.Get("user") != "U1" {
+			t.Errorf("the error should be posted to U1 in C1, but posted to %s in %s", v.Get("user"), v.Get("channel"))
+		}
+		if !strings.HasPrefix(v.Get("text"), "not found") {
+			t.Errorf("unexpected text: %s", v.Get("text"))
+		}
+	case <-time.After(time.Second):
+		t.Error("the error should be posted as an ephemeral message")
+	}
+}
+
+func TestOpenStartModal(t *testing.T) {
+	svc := &fakeAnnictService{
+		called:   make(chan struct{}),
+		episodes: []*resource.Episode{{WorkID: 1, WorkTitle: "work 1"}},
+	}
+	// slack.ModalViewRequest is not used because it fails to decode views which contain checkboxes.
+	var updated struct {
+		ViewID string `json:"view_id"`
+		Hash   string `json:"hash"`
+		View   struct {
+			CallbackID      string `json:"callback_id"`
+			PrivateMetadata string `json:"private_metadata"`
+		} `json:"view"`
+	}
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		res := `{"ok": true}`
+		switch r.URL.Path {
+		case "/views.open":
+			// The trigger ID expires in 3 seconds, so the modal must be opened before listing episodes.
+			select {
+			case <-svc.called:
+				t.Error("the modal should be opened before listing episodes")
+			default:
+			}
+			res = `{"ok": true, "view": {"id": "V1", "hash": "H1"}}`
+		case "/views.update":
+			if err := json.NewDecoder(r.Body).Decode(&updated); err != nil {
+				t.Errorf("failed to decode request body: '%s'", err)
+			}
+		default:
+			t.Errorf("unexpected API call: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := io.WriteString(w, res); err != nil {
+			t.Errorf("WriteString should not return an error, but got '%s'", err)
+		}
+	}))
+	t.Cleanup(api.Close)
+
+	client := slack.New("token", slack.OptionAPIURL(api.URL+"/"))
+	if err := openStartModal(context.Background(), client, svc, "T1", "C1"); err != nil {
+		t.Fatalf("openStartModal should not return an error, but got '%s'", err)
+	}
+	if updated.ViewID != "V1" || updated.Hash != "H1" {
+		t.Errorf("the opened view should be updated, but got view ID %q and hash %q", updated.ViewID, updated.Hash)
+	}
+	if updated.View.CallbackID != startCallbackID || updated.View.PrivateMetadata != "C1" {
+		t.Errorf("the view should be updated to the start modal, but got callback ID %q and private metadata %q",
+			updated.View.CallbackID, updated.View.PrivateMetadata)
+	}
+}
+
+func TestInteractionHandlerRejectsInvalidSignature(t *testing.T) {
+	h := NewInteractionHandler(zap.NewNop(), "another secret", "", "", &fakeAnnictService{})
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newSignedRequest(t, "payload=%7B%7D"))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status is 400, but got %d", w.Code)
+	}
+}
+
+func newSignedRequest(t *testing.T, body string) *http.Request {
+	t.Helper()
+
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(testSigningSecret))
+	if _, err := mac.Write([]byte("v0:" + ts + ":" + body)); err != nil {
+		t.Fatal(err)
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/slack/interactive", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.Header.Set("X-Slack-Request-Timestamp", ts)
+	r.Header.Set("X-Slack-Signature", "v0="+hex.EncodeToString(mac.Sum(nil)))
+	return r
+}
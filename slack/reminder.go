@@ -0,0 +1,112 @@
+package slack
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/GoodCodingFriends/animekai/annict"
+	"github.com/GoodCodingFriends/animekai/errors"
+	"github.com/GoodCodingFriends/animekai/resource"
+	"github.com/GoodCodingFriends/animekai/store"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/morikuni/failure"
+	"github.com/slack-go/slack"
+)
+
+// remindersBucket holds start times of reminded programs keyed by program IDs.
+const remindersBucket = "reminders"
+
+// Reminder posts reminders of programs which start soon.
+type Reminder interface {
+	// Remind posts a reminder of programs of watching works which start within the lead time.
+	// Programs which are already reminded are skipped even across restarts.
+	Remind(ctx context.Context) error
+}
+
+type reminder struct {
+	webhookURL string
+	leadTime   time.Duration
+	store      store.Store
+	now        func() time.Time
+
+	annict annict.Service
+}
+
+// NewReminder returns a Reminder which posts reminders to webhookURL leadTime before programs start.
+// Reminded programs are recorded to st, so st must be persisted on a volume which survives restarts.
+func NewReminder(webhookURL string, leadTime time.Duration, st store.Store, annictService annict.Service) Reminder {
+	return &reminder{
+		webhookURL: webhookURL,
+		leadTime:   leadTime,
+		store:      st,
+		now:        time.Now,
+		annict:     annictService,
+	}
+}
+
+func (r *reminder) Remind(ctx context.Context) error {
+	programs, err := r.annict.ListUpcomingPrograms(ctx, r.leadTime)
+	if err != nil {
+		return failure.Wrap(err)
+	}
+
+	var due []*resource.Program
+	for _, p := range programs {
+		_, err := r.store.Get(remindersBucket, programKey(p))
+		if err == nil {
+			continue
+		}
+		if !failure.Is(err, errors.NotFound) {
+			return failure.Wrap(err)
+		}
+		due = append(due, p)
+	}
+
+	if len(due) != 0 {
+		msg := &slack.Msg{Text: ":bell: starting soon\n" + formatPrograms(due)}
+		if err := postMessage(r.webhookURL, msg); err != nil {
+			return failure.Wrap(err)
+		}
+		for _, p := range due {
+			t, err := ptypes.Timestamp(p.StartTime)
+			if err != nil {
+				return failure.Translate(err, errors.Internal, failure.Context{"program_id": programKey(p)})
+			}
+			if err := r.store.Put(remindersBucket, programKey(p), []byte(t.Format(time.RFC3339))); err != nil {
+				return failure.Wrap(err)
+			}
+		}
+	}
+
+	if err := r.prune(); err != nil {
+		return failure.Wrap(err)
+	}
+	return nil
+}
+
+// prune forgets programs which already started because they are never listed as upcoming programs again.
+func (r *reminder) prune() error {
+	var started []string
+	err := r.store.ForEach(remindersBucket, func(key string, value []byte) error {
+		t, err := time.Parse(time.RFC3339, string(value))
+		if err != nil || t.Before(r.now()) {
+			started = append(started, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return failure.Wrap(err)
+	}
+
+	for _, key := range started {
+		if err := r.store.Delete(remindersBucket, key); err != nil {
+			return failure.Wrap(err)
+		}
+	}
+	return nil
+}
+
+func programKey(p *resource.Program) string {
+	return strconv.Itoa(int(p.Id))
+}
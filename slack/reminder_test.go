@@ -0,0 +1,64 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/GoodCodingFriends/animekai/annict"
+	"github.com/GoodCodingFriends/animekai/errors"
+	"github.com/GoodCodingFriends/animekai/resource"
+	"github.com/GoodCodingFriends/animekai/store"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/morikuni/failure"
+)
+
+type fakeProgramService struct {
+	annict.Service
+	programs []*resource.Program
+}
+
+func (s *fakeProgramService) ListUpcomingPrograms(context.Context, time.Duration) ([]*resource.Program, error) {
+	return s.programs, nil
+}
+
+func TestReminder(t *testing.T) {
+	var posts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&posts, 1)
+	}))
+	t.Cleanup(srv.Close)
+
+	now := time.Now()
+	startTime, err := ptypes.TimestampProto(now.Add(10 * time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := &fakeProgramService{programs: []*resource.Program{{Id: 1, WorkTitle: "のんのんびより", StartTime: startTime}}}
+	st := store.NewMemory()
+
+	if err := NewReminder(srv.URL, 15*time.Minute, st, svc).Remind(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	// A reminder instantiated after a restart shares the store.
+	r := NewReminder(srv.URL, 15*time.Minute, st, svc)
+	if err := r.Remind(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&posts); n != 1 {
+		t.Errorf("the program should be reminded once, but got %d posts", n)
+	}
+
+	// After the program starts, it is forgotten.
+	svc.programs = nil
+	r.(*reminder).now = func() time.Time { return now.Add(time.Hour) }
+	if err := r.Remind(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := st.Get(remindersBucket, "1"); !failure.Is(err, errors.NotFound) {
+		t.Errorf("the started program should be pruned, but got '%v'", err)
+	}
+}
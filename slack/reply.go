@@ -0,0 +1,97 @@
+package slack
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/GoodCodingFriends/animekai/errors"
+	"github.com/morikuni/failure"
+	"github.com/slack-go/slack"
+	"go.uber.org/zap"
+)
+
+var replyClient = &http.Client{Timeout: 10 * time.Second}
+
+func inChannel(text string) *slack.Msg {
+	return &slack.Msg{ResponseType: slack.ResponseTypeInChannel, Text: text}
+}
+
+func ephemeral(text string) *slack.Msg {
+	return &slack.Msg{ResponseType: slack.ResponseTypeEphemeral, Text: text}
+}
+
+// reply posts msg to responseURL. If responseURL is empty or posting to it fails, the text of msg is posted to the
+// incoming webhook instead. Note that ephemeral messages become visible in the channel in that case.
+func reply(logger *zap.Logger, responseURL, webhookURL string, msg *slack.Msg) {
+	if responseURL != "" {
+		err := postMessage(responseURL, msg)
+		if err == nil {
+			return
+		}
+		logger.Warn("failed to post the reply to the response URL, fallback to the webhook", zap.Error(err))
+	}
+
+	if err := slack.PostWebhook(webhookURL, &slack.WebhookMessage{Text: msg.Text}); err != nil {
+		logger.Error("failed to post the reply to the webhook", zap.Error(err))
+	}
+}
+
+// postMessage posts msg as JSON to url, which is a response URL or an incoming webhook URL.
+func postMessage(url string, msg *slack.Msg) error {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		return failure.Translate(err, errors.Internal)
+	}
+
+	res, err := replyClient.Post(url, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return failure.Translate(err, errors.Internal)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return failure.New(errors.Internal, failure.Context{"status": res.Status})
+	}
+	return nil
+}
+
+// errorMessage converts err to a message for users.
+// The innermost message of err follows the description of the error code, and outer messages such as usages
+// follow it line by line.
+func errorMessage(err error) string {
+	var text string
+	c, _ := failure.CodeOf(err)
+	switch c {
+	case errors.InvalidArgument:
+		text = "invalid arguments"
+	case errors.NotFound:
+		text = "not found"
+	case errors.Unauthenticated:
+		text = "failed to authenticate with Annict"
+	case errors.DeadlineExceeded:
+		text = "timed out, please try again later"
+	case errors.Canceled:
+		text = "the request is canceled"
+	default:
+		text = "something went wrong, please see the server log for details"
+	}
+
+	var msgs []string
+	i := failure.NewIterator(err)
+	for i.Next() {
+		var m failure.Message
+		if i.As(&m) {
+			msgs = append(msgs, m.String())
+		}
+	}
+	if len(msgs) == 0 {
+		return text
+	}
+	text += ": " + msgs[len(msgs)-1]
+	for j := len(msgs) - 2; j >= 0; j-- {
+		text += "\n" + msgs[j]
+	}
+	return text
+}
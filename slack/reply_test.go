@@ -0,0 +1,77 @@
+package slack
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GoodCodingFriends/animekai/errors"
+	"github.com/morikuni/failure"
+	"github.com/slack-go/slack"
+	"go.uber.org/zap"
+)
+
+func TestReply(t *testing.T) {
+	newServer := func(t *testing.T, code int, got chan<- map[string]interface{}) string {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			b, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				t.Errorf("failed to read request: '%s'", err)
+			}
+			var m map[string]interface{}
+			if err := json.Unmarshal(b, &m); err != nil {
+				t.Errorf("failed to decode request: '%s'", err)
+			}
+			got <- m
+			w.WriteHeader(code)
+		}))
+		t.Cleanup(srv.Close)
+		return srv.URL
+	}
+
+	cases := map[string]struct {
+		responseCode int
+		webhook      bool
+	}{
+		"response URL":     {responseCode: http.StatusOK},
+		"fallback webhook": {responseCode: http.StatusNotFound, webhook: true},
+	}
+
+	for name, c := range cases {
+		c := c
+		t.Run(name, func(t *testing.T) {
+			responses := make(chan map[string]interface{}, 1)
+			webhooks := make(chan map[string]interface{}, 1)
+			responseURL := newServer(t, c.responseCode, responses)
+			webhookURL := newServer(t, http.StatusOK, webhooks)
+
+			reply(zap.NewNop(), responseURL, webhookURL, ephemeral("hi"))
+
+			if m := <-responses; m["response_type"] != slack.ResponseTypeEphemeral || m["text"] != "hi" {
+				t.Errorf("unexpected reply: %v", m)
+			}
+			select {
+			case m := <-webhooks:
+				if !c.webhook {
+					t.Errorf("webhook should not be called, but got %v", m)
+				}
+			default:
+				if c.webhook {
+					t.Error("webhook should be called")
+				}
+			}
+		})
+	}
+}
+
+func TestErrorMessage(t *testing.T) {
+	err := failure.New(errors.InvalidArgument, failure.Message("--episodes must be a positive number"))
+	err = failure.Wrap(err, failure.Message("usage: /animekai start"))
+
+	want := "invalid arguments: --episodes must be a positive number\nusage: /animekai start"
+	if got := errorMessage(err); got != want {
+		t.Errorf("want %q, but got %q", want, got)
+	}
+}
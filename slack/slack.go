@@ -3,6 +3,7 @@ package slack
 import (
 	"context"
 	"encoding/json"
+	"flag"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -10,10 +11,12 @@ import (
 	"path"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/GoodCodingFriends/animekai/annict"
+	"github.com/GoodCodingFriends/animekai/errors"
 	"github.com/GoodCodingFriends/animekai/resource"
-	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/morikuni/failure"
 	"github.com/slack-go/slack"
 	"go.uber.org/zap"
@@ -23,17 +26,27 @@ type commandHandler struct {
 	logger        *zap.Logger
 	signingSecret string
 	webhookURL    string
+	// client is nil if no bot token is specified.
+	client *slack.Client
+	router *router
 
 	annict annict.Service
 }
 
-func NewCommandHandler(logger *zap.Logger, signingSecret, webhookURL string, annictService annict.Service) http.Handler {
-	return &commandHandler{
+// NewCommandHandler returns a handler for slash commands.
+// If botToken is not empty, "start" without arguments opens a modal to select works to be recorded.
+func NewCommandHandler(logger *zap.Logger, signingSecret, webhookURL, botToken string, annictService annict.Service) http.Handler {
+	h := &commandHandler{
 		logger:        logger,
 		signingSecret: signingSecret,
 		webhookURL:    webhookURL,
 		annict:        annictService,
 	}
+	if botToken != "" {
+		h.client = slack.New(botToken)
+	}
+	h.router = newRouter(logger, h.commands()...)
+	return h
 }
 
 // TODO: Use failure.Code.
@@ -65,8 +78,7 @@ func (h *commandHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	sp := strings.Split(cmd.Text, " ")
-	h.handle(sp) // handle runs asynchronously.
+	h.handle(&cmd) // handle runs asynchronously.
 
 	w.Header().Set("Content-Type", "application/json")
 	params := &slack.Msg{ResponseType: slack.ResponseTypeInChannel}
@@ -75,66 +87,448 @@ func (h *commandHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (h *commandHandler) handle(args []string) {
+func (h *commandHandler) handle(cmd *slack.SlashCommand) {
 	go func() {
-		msg := func() string {
-			switch args[0] {
-			case "start":
-				h.logger.Info("start")
-				episodes, err := start(ctxzap.ToContext(context.Background(), h.logger.Named("start")), h.annict)
-				if err != nil {
-					h.logger.Error("failed to call start", zap.Error(err))
-					return ""
-				}
+		msg, err := h.router.route(cmd)
+		if err != nil {
+			h.logger.Error("failed to process command", zap.String("text", cmd.Text), zap.Error(err))
+			msg = ephemeral(errorMessage(err))
+		}
+		if msg == nil {
+			return
+		}
 
-				var text string
-				for _, e := range episodes {
-					text += fmt.Sprintf("- %s %s %s\n", e.WorkTitle, e.NumberText, e.Title)
-				}
+		reply(h.logger, cmd.ResponseURL, h.webhookURL, msg)
+	}()
+}
 
-				return text
-			case "add":
-				h.logger.Info("add")
-				if len(args) == 1 || args[1] == "-h" || args[1] == "--help" {
-					return "usage: /animekai add https://annict.jp/works/<workID>"
-				}
-				ctx := ctxzap.ToContext(context.Background(), h.logger.Named("add"))
-				if err := add(ctx, h.annict, args[1:]); err != nil {
-					return ""
-				}
-				return ":lgtm-1:"
+func (h *commandHandler) commands() []*command {
+	return []*command{
+		{
+			name:    "start",
+			args:    "[<workID or title>...] [-<workID or title>...] [<workID or title>=good|great|average|bad...] [-- <comment>]",
+			summary: "record the next episodes of watching works, or open a modal to select them if no arguments are passed",
+			setup:   h.start,
+		},
+		{
+			name:    "undo",
+			summary: "delete the records created by the latest start",
+			setup:   h.undo,
+		},
+		{
+			name:    "review",
+			args:    "<workID> [overall|animation|music|story|character=good|great|average|bad...] -- <body>",
+			summary: "write a review of the work",
+			minArgs: 1,
+			setup:   h.review,
+		},
+		{
+			name:    "list",
+			args:    "[watching|watched|wanna]",
+			summary: "list works with the progress",
+			setup:   h.list,
+		},
+		{
+			name:    "status",
+			summary: "show the numbers of records and works",
+			setup:   h.status,
+		},
+		{
+			name:    "tonight",
+			summary: "list programs of watching works which broadcast unwatched episodes soon",
+			setup:   h.tonight,
+		},
+		{
+			name:    "add",
+			args:    "<workID, https://annict.jp/works/<workID> or title>",
+			summary: "mark the work as watching",
+			minArgs: 1,
+			setup:   h.add,
+		},
+		h.updateStatusCommand("drop", annict.StatusStateStopWatching, "mark the work as stopped watching"),
+		h.updateStatusCommand("hold", annict.StatusStateOnHold, "mark the work as on hold"),
+		h.updateStatusCommand("finish", annict.StatusStateWatched, "mark the work as watched"),
+		h.updateStatusCommand("wanna", annict.StatusStateWannaWatch, "mark the work as wanna watch"),
+	}
+}
+
+func (h *commandHandler) start(fs *flag.FlagSet) runFunc {
+	dryRun := fs.Bool("dry-run", false, "show the episodes to be recorded without recording them")
+	episodes := fs.Int("episodes", 1, "record `n` episodes per work")
+	return func(ctx context.Context, cmd *slack.SlashCommand, args []string) (*slack.Msg, error) {
+		if len(args) == 0 && fs.NFlag() == 0 && h.client != nil {
+			if err := openStartModal(ctx, h.client, h.annict, cmd.TriggerID, cmd.ChannelID); err != nil {
+				return nil, failure.Wrap(err)
 			}
-			return ""
-		}()
-
-		err := slack.PostWebhook(
-			h.webhookURL,
-			&slack.WebhookMessage{
-				Text: msg,
-			},
-		)
+			return nil, nil
+		}
+
+		if *episodes < 1 {
+			return nil, failure.New(errors.InvalidArgument, failure.Context{"episodes": strconv.Itoa(*episodes)}, failure.Message("--episodes must be a positive number"))
+		}
+		opts, err := parseStartArgs(args)
 		if err != nil {
-			h.logger.Error("failed to process command", zap.Error(err))
+			return nil, failure.Wrap(err)
 		}
-	}()
+		opts = append(opts, annict.WithEpisodes(*episodes))
+		if *dryRun {
+			opts = append(opts, annict.DryRun())
+		}
+		recorded, err := start(ctx, h.annict, opts)
+		if err != nil {
+			return nil, failure.Wrap(err)
+		}
+
+		var text string
+		if *dryRun {
+			text = "dry-run: the following episodes will be recorded\n"
+		}
+		if len(recorded) == 0 {
+			text += "no episodes to be recorded"
+		}
+		text += formatEpisodes(recorded)
+		return inChannel(text), nil
+	}
+}
+
+func (h *commandHandler) undo(*flag.FlagSet) runFunc {
+	return func(ctx context.Context, _ *slack.SlashCommand, _ []string) (*slack.Msg, error) {
+		episodes, err := undo(ctx, h.annict)
+		if err != nil {
+			return nil, failure.Wrap(err)
+		}
+		return inChannel("undone: the following records are deleted\n" + formatEpisodes(episodes)), nil
+	}
+}
+
+func (h *commandHandler) review(*flag.FlagSet) runFunc {
+	return func(ctx context.Context, _ *slack.SlashCommand, args []string) (*slack.Msg, error) {
+		workID, review, err := parseReviewArgs(args)
+		if err != nil {
+			return nil, failure.Wrap(err)
+		}
+		r, err := h.annict.CreateReview(ctx, workID, review)
+		if err != nil {
+			return nil, failure.Wrap(err)
+		}
+		return inChannel(fmt.Sprintf("reviewed %s", r.WorkTitle)), nil
+	}
+}
+
+func (h *commandHandler) list(fs *flag.FlagSet) runFunc {
+	cursor := fs.String("after", "", "list works after the `cursor`")
+	return func(ctx context.Context, _ *slack.SlashCommand, args []string) (*slack.Msg, error) {
+		state := "watching"
+		if len(args) > 0 {
+			state = args[0]
+		}
+		if _, ok := listStates[state]; !ok || len(args) > 1 {
+			return nil, failure.New(errors.InvalidArgument, failure.Messagef("unknown state %q", strings.Join(args, " ")))
+		}
+		works, nextCursor, err := h.annict.ListWorks(ctx, listStates[state], *cursor, listPageSize)
+		if err != nil {
+			return nil, failure.Wrap(err)
+		}
+		return inChannel(formatWorks(state, works, nextCursor)), nil
+	}
+}
+
+func (h *commandHandler) status(*flag.FlagSet) runFunc {
+	return func(ctx context.Context, _ *slack.SlashCommand, _ []string) (*slack.Msg, error) {
+		p, err := h.annict.GetProfile(ctx)
+		if err != nil {
+			return nil, failure.Wrap(err)
+		}
+		return inChannel(fmt.Sprintf(
+			"records: %d\nwatching: %d\nwatched: %d\nwanna watch: %d",
+			p.RecordsCount, p.WatchingCount, p.WatchedCount, p.WannaWatchCount,
+		)), nil
+	}
+}
+
+func (h *commandHandler) tonight(fs *flag.FlagSet) runFunc {
+	hours := fs.Int("hours", 12, "list programs starting within `n` hours")
+	return func(ctx context.Context, _ *slack.SlashCommand, _ []string) (*slack.Msg, error) {
+		if *hours < 1 {
+			return nil, failure.New(errors.InvalidArgument, failure.Context{"hours": strconv.Itoa(*hours)}, failure.Message("--hours must be a positive number"))
+		}
+		programs, err := h.annict.ListUpcomingPrograms(ctx, time.Duration(*hours)*time.Hour)
+		if err != nil {
+			return nil, failure.Wrap(err)
+		}
+		if len(programs) == 0 {
+			return inChannel(fmt.Sprintf("no programs within %d hours", *hours)), nil
+		}
+		return inChannel(formatPrograms(programs)), nil
+	}
+}
+
+func (h *commandHandler) add(fs *flag.FlagSet) runFunc {
+	record := fs.Bool("record", false, "record the first episode as well")
+	byTitle := fs.Bool("title", false, "search the work by the title even if it is a number")
+	return func(ctx context.Context, _ *slack.SlashCommand, args []string) (*slack.Msg, error) {
+		msg, err := add(ctx, h.annict, args, *record, *byTitle)
+		if err != nil {
+			return nil, failure.Wrap(err)
+		}
+		return msg, nil
+	}
+}
+
+// updateStatusCommand returns the command which changes the state of the work to state.
+func (h *commandHandler) updateStatusCommand(name string, state annict.StatusState, summary string) *command {
+	return &command{
+		name:    name,
+		args:    "<workID or https://annict.jp/works/<workID>>",
+		summary: summary,
+		minArgs: 1,
+		setup: func(*flag.FlagSet) runFunc {
+			return func(ctx context.Context, _ *slack.SlashCommand, args []string) (*slack.Msg, error) {
+				if err := updateStatus(ctx, h.annict, args[0], state); err != nil {
+					return nil, failure.Wrap(err)
+				}
+				return inChannel(":lgtm-1:"), nil
+			}
+		},
+	}
+}
+
+// listPageSize is the number of works listed by list at once.
+const listPageSize = 20
+
+var listStates = map[string]annict.StatusState{
+	"watching": annict.StatusStateWatching,
+	"watched":  annict.StatusStateWatched,
+	"wanna":    annict.StatusStateWannaWatch,
+}
+
+// formatWorks renders works with their progress such as "3/12".
+func formatWorks(state string, works []*resource.Work, nextCursor string) string {
+	if len(works) == 0 {
+		return "no works"
+	}
+
+	var text string
+	for _, w := range works {
+		total := "?"
+		if w.EpisodesCount != 0 {
+			total = strconv.Itoa(int(w.EpisodesCount))
+		}
+		text += fmt.Sprintf("- %s (%d/%s)\n", w.Title, w.WatchedEpisodesCount, total)
+	}
+	// ListWorks doesn't tell whether the next page exists, so a full page is regarded as having the next page.
+	if nextCursor != "" && len(works) == listPageSize {
+		text += fmt.Sprintf("next page: `/animekai list %s --after %s`", state, nextCursor)
+	}
+	return text
+}
+
+// formatPrograms renders programs with their start times in JST.
+func formatPrograms(programs []*resource.Program) string {
+	var text string
+	for _, p := range programs {
+		var start string
+		if t, err := ptypes.Timestamp(p.StartTime); err == nil {
+			start = t.In(annict.JST).Format("01/02 15:04")
+		}
+		text += fmt.Sprintf("- %s [%s] %s %s %s", start, p.ChannelName, p.WorkTitle, p.EpisodeNumberText, p.EpisodeTitle)
+		if p.Rebroadcast {
+			text += " (rebroadcast)"
+		}
+		text += "\n"
+	}
+	return text
+}
+
+func formatEpisodes(episodes []*resource.Episode) string {
+	var text string
+	for _, e := range episodes {
+		text += fmt.Sprintf("- %s %s %s\n", e.WorkTitle, e.NumberText, e.Title)
+	}
+	return text
+}
+
+// parseStartArgs parses positional arguments of start.
+// Each argument is a work ID or a part of the title of a work to be recorded.
+// Arguments prefixed with "-" exclude works instead.
+// "<workID or title>=<rating>" rates the work with good, great, average or bad if it is recorded. It doesn't select
+// the work, so it can be combined with the other arguments.
+// Arguments after "--" are joined as the comment of the records.
+func parseStartArgs(args []string) ([]annict.RecordOption, error) {
+	var (
+		opts             []annict.RecordOption
+		include, exclude []string
+	)
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--":
+			if comment := strings.TrimSpace(strings.Join(args[i+1:], " ")); comment != "" {
+				opts = append(opts, annict.WithComment(comment))
+			}
+			i = len(args)
+		case strings.HasPrefix(arg, "-"):
+			exclude = append(exclude, strings.TrimPrefix(arg, "-"))
+		case strings.Contains(arg, "="):
+			idx := strings.LastIndex(arg, "=")
+			pattern, rating := arg[:idx], annict.RatingState(strings.ToUpper(arg[idx+1:]))
+			if pattern == "" || !rating.IsValid() {
+				return nil, failure.New(errors.InvalidArgument, failure.Context{"rating": arg}, failure.Message("rating must be one of good, great, average or bad"))
+			}
+			opts = append(opts, annict.WithRating(pattern, rating))
+		default:
+			include = append(include, arg)
+		}
+	}
+	if len(include) != 0 {
+		opts = append(opts, annict.IncludeWorks(include...))
+	}
+	if len(exclude) != 0 {
+		opts = append(opts, annict.ExcludeWorks(exclude...))
+	}
+	return opts, nil
+}
+
+// parseReviewArgs parses arguments of review.
+// The first argument is the work ID. "<axis>=<rating>" rates an axis of the work, and arguments after "--" are
+// joined as the review body.
+func parseReviewArgs(args []string) (int32, *resource.Review, error) {
+	workID, err := strconv.ParseInt(args[0], 10, 32)
+	if err != nil {
+		return 0, nil, failure.Translate(err, errors.InvalidArgument, failure.Context{"work_id": args[0]})
+	}
+
+	review := &resource.Review{}
+	for i := 1; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			review.Body = strings.TrimSpace(strings.Join(args[i+1:], " "))
+			break
+		}
+
+		sp := strings.SplitN(arg, "=", 2)
+		if len(sp) != 2 {
+			return 0, nil, failure.New(errors.InvalidArgument, failure.Context{"arg": arg})
+		}
+		rating, ok := resource.Review_Rating_value[strings.ToUpper(sp[1])]
+		if !ok || rating == int32(resource.Review_RATING_UNSPECIFIED) {
+			return 0, nil, failure.New(errors.InvalidArgument, failure.Context{"rating": sp[1]})
+		}
+		switch sp[0] {
+		case "overall":
+			review.OverallRating = resource.Review_Rating(rating)
+		case "animation":
+			review.AnimationRating = resource.Review_Rating(rating)
+		case "music":
+			review.MusicRating = resource.Review_Rating(rating)
+		case "story":
+			review.StoryRating = resource.Review_Rating(rating)
+		case "character":
+			review.CharacterRating = resource.Review_Rating(rating)
+		default:
+			return 0, nil, failure.New(errors.InvalidArgument, failure.Context{"axis": sp[0]})
+		}
+	}
+	if review.Body == "" {
+		return 0, nil, failure.New(errors.InvalidArgument, failure.Message("review body must not be empty"))
+	}
+	return int32(workID), review, nil
 }
 
-func start(ctx context.Context, annictService annict.Service) ([]*resource.Episode, error) {
-	episodes, err := annictService.CreateNextEpisodeRecords(ctx)
+func start(ctx context.Context, annictService annict.Service, opts []annict.RecordOption) ([]*resource.Episode, error) {
+	episodes, err := annictService.CreateNextEpisodeRecords(ctx, opts...)
 	if err != nil {
 		return nil, failure.Wrap(err)
 	}
 	return episodes, nil
 }
 
-func add(ctx context.Context, annictService annict.Service, args []string) error {
-	v := path.Base(args[0])
-	workID, err := strconv.Atoi(v)
+func undo(ctx context.Context, annictService annict.Service) ([]*resource.Episode, error) {
+	episodes, err := annictService.UndoRecordSession(ctx)
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+	return episodes, nil
+}
+
+// searchLimit is the maximum number of candidates shown by add.
+const searchLimit = 10
+
+// add marks the work as WATCHING. If record is true, the first episode is recorded as well.
+// The work is specified by a work ID, an Annict work URL or words of its title. If several works match the title,
+// add replies with the numbered candidates and the commands to add them instead.
+// A number is searched as a title if byTitle is true or no work has the ID, so that titles like "86" can be added.
+func add(ctx context.Context, annictService annict.Service, words []string, record, byTitle bool) (*slack.Msg, error) {
+	if len(words) == 0 {
+		return nil, failure.New(errors.InvalidArgument, failure.Message("a work must be specified"))
+	}
+	var opts []annict.StatusOption
+	if record {
+		opts = append(opts, annict.RecordFirstEpisode())
+	}
+
+	if len(words) == 1 && !byTitle && isWorkID(words[0]) {
+		err := updateStatus(ctx, annictService, words[0], annict.StatusStateWatching, opts...)
+		if err == nil {
+			return inChannel(":lgtm-1:"), nil
+		}
+		// A number may be a title rather than an ID, so it is searched as a title if no work has the ID.
+		if !failure.Is(err, errors.NotFound) || strings.Contains(words[0], "/") {
+			return nil, failure.Wrap(err)
+		}
+	}
+
+	title := strings.Join(words, " ")
+	works, err := annictService.SearchWorks(ctx, title, searchLimit)
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+	switch len(works) {
+	case 0:
+		return nil, failure.New(errors.NotFound, failure.Messagef("no works match %q", title))
+	case 1:
+		if err := annictService.UpdateWorkStatus(ctx, int(works[0].Id), annict.StatusStateWatching, opts...); err != nil {
+			return nil, failure.Wrap(err)
+		}
+		return inChannel(fmt.Sprintf(":lgtm-1: added %s", works[0].Title)), nil
+	}
+	return ephemeral(formatCandidates(title, works, record)), nil
+}
+
+// isWorkID reports whether s is a work ID or an Annict work URL rather than a title.
+func isWorkID(s string) bool {
+	if !strings.HasPrefix(s, "http://") && !strings.HasPrefix(s, "https://") && strings.Contains(s, "/") {
+		return false
+	}
+	_, err := strconv.Atoi(path.Base(s))
+	return err == nil
+}
+
+// formatCandidates renders works matched with title as a numbered list with commands to add each of them.
+func formatCandidates(title string, works []*resource.Work, record bool) string {
+	cmd := "/animekai add"
+	if record {
+		cmd += " --record"
+	}
+	text := fmt.Sprintf("%d works match %q, run one of the following commands:\n", len(works), title)
+	for i, w := range works {
+		text += fmt.Sprintf("%d. %s", i+1, w.Title)
+		if w.ReleasedOn != "" {
+			text += fmt.Sprintf(" (%s)", w.ReleasedOn)
+		}
+		text += fmt.Sprintf(" `%s %d`\n", cmd, w.Id)
+	}
+	return text
+}
+
+// updateStatus updates the state of the work specified by a work ID or an Annict work URL.
+func updateStatus(ctx context.Context, annictService annict.Service, work string, state annict.StatusState, opts ...annict.StatusOption) error {
+	workID, err := strconv.Atoi(path.Base(work))
 	if err != nil {
-		return failure.Wrap(err, failure.Context{"work_id": args[0]})
+		return failure.Translate(err, errors.InvalidArgument, failure.Context{"work_id": work})
 	}
 
-	if err := annictService.UpdateWorkStatus(ctx, workID, annict.StatusStateWatching); err != nil {
+	if err := annictService.UpdateWorkStatus(ctx, workID, state, opts...); err != nil {
 		return failure.Wrap(err)
 	}
 	return nil
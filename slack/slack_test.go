@@ -0,0 +1,127 @@
+package slack
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/GoodCodingFriends/animekai/annict"
+	"github.com/GoodCodingFriends/animekai/errors"
+	"github.com/GoodCodingFriends/animekai/resource"
+	"github.com/morikuni/failure"
+)
+
+type fakeSearchService struct {
+	annict.Service
+	works []*resource.Work
+	// missingID is the ID of a work which doesn't exist.
+	missingID int
+	updated   []int
+}
+
+func (s *fakeSearchService) SearchWorks(context.Context, string, int32) ([]*resource.Work, error) {
+	return s.works, nil
+}
+
+func (s *fakeSearchService) UpdateWorkStatus(_ context.Context, id int, _ annict.StatusState, _ ...annict.StatusOption) error {
+	if id == s.missingID {
+		return failure.New(errors.NotFound)
+	}
+	s.updated = append(s.updated, id)
+	return nil
+}
+
+func TestAdd(t *testing.T) {
+	candidates := []*resource.Work{
+		{Id: 1, Title: "のんのんびより", ReleasedOn: "2013 秋"},
+		{Id: 2, Title: "のんのんびより りぴーと", ReleasedOn: "2015 夏"},
+	}
+
+	cases := map[string]struct {
+		args        []string
+		record      bool
+		byTitle     bool
+		works       []*resource.Work
+		missingID   int
+		wantUpdated int
+		wantText    string
+		wantCode    failure.StringCode
+	}{
+		"work URL": {
+			args:        []string{"https://annict.jp/works/615"},
+			works:       candidates,
+			wantUpdated: 615,
+		},
+		"work ID": {
+			args:        []string{"615"},
+			works:       candidates,
+			wantUpdated: 615,
+		},
+		"number title": {
+			args:        []string{"86"},
+			works:       []*resource.Work{{Id: 8888, Title: "86―エイティシックス―"}},
+			missingID:   86,
+			wantUpdated: 8888,
+			wantText:    "added 86―エイティシックス―",
+		},
+		"number title by title": {
+			args:        []string{"86"},
+			byTitle:     true,
+			works:       []*resource.Work{{Id: 8888, Title: "86―エイティシックス―"}},
+			wantUpdated: 8888,
+			wantText:    "added 86―エイティシックス―",
+		},
+		"missing work URL": {
+			args:      []string{"https://annict.jp/works/615"},
+			works:     candidates,
+			missingID: 615,
+			wantCode:  errors.NotFound,
+		},
+		"single match": {
+			args:        []string{"のんのん", "びより"},
+			works:       candidates[:1],
+			wantUpdated: 1,
+			wantText:    "added のんのんびより",
+		},
+		"multiple matches": {
+			args:     []string{"のんのん"},
+			record:   true,
+			works:    candidates,
+			wantText: "2. のんのんびより りぴーと (2015 夏) `/animekai add --record 2`",
+		},
+		"no matches": {
+			args:     []string{"のんのん"},
+			wantCode: errors.NotFound,
+		},
+		"no works": {
+			record:   true,
+			wantCode: errors.InvalidArgument,
+		},
+	}
+
+	for name, c := range cases {
+		c := c
+		t.Run(name, func(t *testing.T) {
+			svc := &fakeSearchService{works: c.works, missingID: c.missingID}
+			msg, err := add(context.Background(), svc, c.args, c.record, c.byTitle)
+			if c.wantCode != "" {
+				if !failure.Is(err, c.wantCode) {
+					t.Fatalf("expected %s, but got '%v'", c.wantCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c.wantUpdated == 0 && len(svc.updated) != 0 {
+				t.Errorf("no works should be updated, but got %v", svc.updated)
+			}
+			if c.wantUpdated != 0 && (len(svc.updated) != 1 || svc.updated[0] != c.wantUpdated) {
+				t.Errorf("work %d should be updated, but got %v", c.wantUpdated, svc.updated)
+			}
+			if !strings.Contains(msg.Text, c.wantText) {
+				t.Errorf("%q should contain %q", msg.Text, c.wantText)
+			}
+		})
+	}
+}
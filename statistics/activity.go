@@ -0,0 +1,77 @@
+package statistics
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/GoodCodingFriends/animekai/annict"
+	"github.com/GoodCodingFriends/animekai/api"
+	"github.com/GoodCodingFriends/animekai/errors"
+	"github.com/GoodCodingFriends/animekai/resource"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/morikuni/failure"
+)
+
+const dateLayout = "2006-01-02"
+
+func (s *service) GetActivity(ctx context.Context, req *api.GetActivityRequest) (*api.GetActivityResponse, error) {
+	records, err := s.annict.ListRecords(ctx, time.Time{}, time.Now())
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+
+	activity, err := aggregateActivity(records)
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+	return &api.GetActivityResponse{Activity: activity}, nil
+}
+
+// aggregateActivity counts records per day, week and month in JST.
+func aggregateActivity(records []*resource.Record) (*resource.Activity, error) {
+	daily, weekly, monthly := map[string]int32{}, map[string]int32{}, map[string]int32{}
+	for _, r := range records {
+		t, err := ptypes.Timestamp(r.CreateTime)
+		if err != nil {
+			return nil, failure.Translate(err, errors.Internal, failure.Context{"record_id": r.Id})
+		}
+		day := startOfDay(t)
+
+		daily[day.Format(dateLayout)]++
+		weekly[startOfWeek(t).Format(dateLayout)]++
+		monthly[day.AddDate(0, 0, 1-day.Day()).Format(dateLayout)]++
+	}
+
+	return &resource.Activity{
+		Daily:   toCounts(daily),
+		Weekly:  toCounts(weekly),
+		Monthly: toCounts(monthly),
+	}, nil
+}
+
+// startOfDay returns the beginning of the date of t in JST.
+func startOfDay(t time.Time) time.Time {
+	t = t.In(annict.JST)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, annict.JST)
+}
+
+// startOfWeek returns the beginning of the week of t in JST. Weeks start on Monday.
+func startOfWeek(t time.Time) time.Time {
+	day := startOfDay(t)
+	// time.Weekday starts on Sunday.
+	return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+}
+
+// toCounts converts counts keyed by dates to a chronological list.
+func toCounts(m map[string]int32) []*resource.Activity_Count {
+	counts := make([]*resource.Activity_Count, 0, len(m))
+	for date, n := range m {
+		counts = append(counts, &resource.Activity_Count{Date: date, EpisodesCount: n})
+	}
+	// Dates in YYYY-MM-DD format are sorted lexicographically.
+	sort.Slice(counts, func(i, j int) bool {
+		return counts[i].Date < counts[j].Date
+	})
+	return counts
+}
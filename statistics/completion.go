@@ -0,0 +1,181 @@
+package statistics
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/GoodCodingFriends/animekai/annict"
+	"github.com/GoodCodingFriends/animekai/api"
+	"github.com/GoodCodingFriends/animekai/errors"
+	"github.com/GoodCodingFriends/animekai/resource"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/morikuni/failure"
+	"golang.org/x/sync/errgroup"
+)
+
+func (s *service) GetCompletionStats(ctx context.Context, req *api.GetCompletionStatsRequest) (*api.GetCompletionStatsResponse, error) {
+	if err := validateGetCompletionStatsRequest(req); err != nil {
+		return nil, failure.Wrap(err)
+	}
+
+	now := time.Now()
+	var (
+		records       []*resource.Record
+		watchingWorks []*resource.Work
+
+		eg errgroup.Group
+	)
+	eg.Go(func() error {
+		r, err := s.annict.ListRecords(ctx, time.Time{}, now)
+		if err != nil {
+			return failure.Wrap(err)
+		}
+		records = r
+		return nil
+	})
+	eg.Go(func() error {
+		w, err := s.annict.ListAllWorks(ctx, annict.StatusStateWatching)
+		if err != nil {
+			return failure.Wrap(err)
+		}
+		watchingWorks = w
+		return nil
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, failure.Wrap(err)
+	}
+
+	stats, err := aggregateCompletionStats(records, watchingWorks, now)
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+	if len(stats.FinishedWorks) > int(req.Limit) {
+		stats.FinishedWorks = stats.FinishedWorks[:req.Limit]
+	}
+	if len(stats.WatchingWorks) > int(req.Limit) {
+		stats.WatchingWorks = stats.WatchingWorks[:req.Limit]
+	}
+	return &api.GetCompletionStatsResponse{Stats: stats}, nil
+}
+
+// workProgress is the progress of a work aggregated from records.
+type workProgress struct {
+	workID                int32
+	title                 string
+	episodes              map[int32]struct{}
+	beginTime, finishTime time.Time
+}
+
+// aggregateCompletionStats aggregates records in chronological order into completion stats.
+// Works which are not finished are reported only if they are in watchingWorks.
+func aggregateCompletionStats(records []*resource.Record, watchingWorks []*resource.Work, now time.Time) (*resource.CompletionStats, error) {
+	works, byWork, err := workProgresses(records)
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+
+	stats := &resource.CompletionStats{}
+	for _, w := range works {
+		if w.finishTime.IsZero() {
+			continue
+		}
+		c, err := w.completion(w.finishTime)
+		if err != nil {
+			return nil, failure.Wrap(err)
+		}
+		stats.FinishedWorks = append(stats.FinishedWorks, c)
+	}
+	for _, watching := range watchingWorks {
+		w, ok := byWork[watching.Id]
+		if !ok || !w.finishTime.IsZero() {
+			continue
+		}
+		c, err := w.completion(now)
+		if err != nil {
+			return nil, failure.Wrap(err)
+		}
+		stats.WatchingWorks = append(stats.WatchingWorks, c)
+	}
+
+	byDays := func(works []*resource.CompletionStats_Work) func(i, j int) bool {
+		return func(i, j int) bool {
+			return works[i].Days > works[j].Days
+		}
+	}
+	sort.SliceStable(stats.FinishedWorks, byDays(stats.FinishedWorks))
+	sort.SliceStable(stats.WatchingWorks, byDays(stats.WatchingWorks))
+
+	if n := len(stats.FinishedWorks); n != 0 {
+		stats.LongestWork = stats.FinishedWorks[0]
+		// FinishedWorks is in descending order, so the median is the same as the ascending one.
+		if n%2 == 1 {
+			stats.MedianDays = float64(stats.FinishedWorks[n/2].Days)
+		} else {
+			stats.MedianDays = float64(stats.FinishedWorks[n/2-1].Days+stats.FinishedWorks[n/2].Days) / 2
+		}
+	}
+	return stats, nil
+}
+
+// workProgresses aggregates records in chronological order into the progress of each work.
+// Works are in the order of the first record.
+func workProgresses(records []*resource.Record) ([]*workProgress, map[int32]*workProgress, error) {
+	var (
+		works  []*workProgress
+		byWork = map[int32]*workProgress{}
+	)
+	for _, r := range records {
+		t, err := ptypes.Timestamp(r.CreateTime)
+		if err != nil {
+			return nil, nil, failure.Translate(err, errors.Internal, failure.Context{"record_id": r.Id})
+		}
+		w, ok := byWork[r.WorkId]
+		if !ok {
+			w = &workProgress{workID: r.WorkId, title: r.WorkTitle, episodes: map[int32]struct{}{}, beginTime: t}
+			byWork[r.WorkId] = w
+			works = append(works, w)
+		}
+		w.episodes[r.EpisodeSortNumber] = struct{}{}
+		if r.LastEpisode {
+			w.finishTime = t
+		}
+	}
+	return works, byWork, nil
+}
+
+// completion converts w into the stats of a work which is finished or still watched at until.
+func (w *workProgress) completion(until time.Time) (*resource.CompletionStats_Work, error) {
+	days := daysBetween(w.beginTime, until)
+	c := &resource.CompletionStats_Work{
+		Id:             w.workID,
+		Title:          w.title,
+		EpisodesCount:  int32(len(w.episodes)),
+		Days:           int32(days),
+		EpisodesPerDay: float64(len(w.episodes)) / float64(days),
+	}
+
+	beginTime, err := ptypes.TimestampProto(w.beginTime)
+	if err != nil {
+		return nil, failure.Translate(err, errors.Internal, failure.Context{"work_id": strconv.Itoa(int(w.workID))})
+	}
+	c.BeginTime = beginTime
+	if !w.finishTime.IsZero() {
+		finishTime, err := ptypes.TimestampProto(w.finishTime)
+		if err != nil {
+			return nil, failure.Translate(err, errors.Internal, failure.Context{"work_id": strconv.Itoa(int(w.workID))})
+		}
+		c.FinishTime = finishTime
+	}
+	return c, nil
+}
+
+// daysBetween returns the number of dates in JST from begin to end inclusive.
+func daysBetween(begin, end time.Time) int {
+	date := func(t time.Time) time.Time {
+		t = t.In(annict.JST)
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	return int(date(end).Sub(date(begin)).Hours()/24) + 1
+}
@@ -0,0 +1,113 @@
+package statistics
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/GoodCodingFriends/animekai/annict"
+	"github.com/GoodCodingFriends/animekai/api"
+	"github.com/GoodCodingFriends/animekai/resource"
+	"github.com/morikuni/failure"
+	"golang.org/x/sync/errgroup"
+)
+
+func (s *service) GetSeasonBreakdown(ctx context.Context, req *api.GetSeasonBreakdownRequest) (*api.GetSeasonBreakdownResponse, error) {
+	states := []annict.StatusState{annict.StatusStateWatching, annict.StatusStateWatched, annict.StatusStateStopWatching}
+	worksByState := make([][]*resource.Work, len(states))
+
+	var (
+		records []*resource.Record
+		eg      errgroup.Group
+	)
+	for i, state := range states {
+		i, state := i, state
+		eg.Go(func() error {
+			works, err := s.annict.ListAllWorks(ctx, state)
+			if err != nil {
+				return failure.Wrap(err)
+			}
+			worksByState[i] = works
+			return nil
+		})
+	}
+	eg.Go(func() error {
+		r, err := s.annict.ListRecords(ctx, time.Time{}, time.Now())
+		if err != nil {
+			return failure.Wrap(err)
+		}
+		records = r
+		return nil
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, failure.Wrap(err)
+	}
+
+	// ListAllWorks doesn't count watched episodes, so they are counted from records.
+	watched := watchedEpisodesCounts(records)
+	var works []*resource.Work
+	for _, ws := range worksByState {
+		for _, w := range ws {
+			w.WatchedEpisodesCount = watched[w.Id]
+		}
+		works = append(works, ws...)
+	}
+	return &api.GetSeasonBreakdownResponse{Breakdown: aggregateSeasonBreakdown(works)}, nil
+}
+
+// watchedEpisodesCounts returns the numbers of distinct recorded episodes keyed by work IDs.
+func watchedEpisodesCounts(records []*resource.Record) map[int32]int32 {
+	episodes := map[int32]map[int32]struct{}{}
+	for _, r := range records {
+		if episodes[r.WorkId] == nil {
+			episodes[r.WorkId] = map[int32]struct{}{}
+		}
+		episodes[r.WorkId][r.EpisodeSortNumber] = struct{}{}
+	}
+	counts := make(map[int32]int32, len(episodes))
+	for id, es := range episodes {
+		counts[id] = int32(len(es))
+	}
+	return counts
+}
+
+// aggregateSeasonBreakdown groups works by seasons when they are released.
+func aggregateSeasonBreakdown(works []*resource.Work) *resource.SeasonBreakdown {
+	type key struct {
+		year   int32
+		season resource.Work_Season
+	}
+	bySeason := map[key]*resource.SeasonBreakdown_Season{}
+	for _, w := range works {
+		k := key{year: w.SeasonYear, season: w.SeasonName}
+		s, ok := bySeason[k]
+		if !ok {
+			s = &resource.SeasonBreakdown_Season{Year: k.year, Season: k.season}
+			bySeason[k] = s
+		}
+		switch w.Status {
+		case resource.Work_WATCHING:
+			s.WatchingCount++
+		case resource.Work_WATCHED:
+			s.WatchedCount++
+		case resource.Work_DROPPED:
+			s.DroppedCount++
+		}
+		s.EpisodesCount += w.EpisodesCount
+		s.WatchedEpisodesCount += w.WatchedEpisodesCount
+	}
+
+	breakdown := &resource.SeasonBreakdown{Seasons: make([]*resource.SeasonBreakdown_Season, 0, len(bySeason))}
+	for _, s := range bySeason {
+		breakdown.Seasons = append(breakdown.Seasons, s)
+	}
+	// Seasons are declared in the order of a year.
+	sort.Slice(breakdown.Seasons, func(i, j int) bool {
+		a, b := breakdown.Seasons[i], breakdown.Seasons[j]
+		if a.Year != b.Year {
+			return a.Year < b.Year
+		}
+		return a.Season < b.Season
+	})
+	return breakdown
+}
@@ -0,0 +1,75 @@
+package statistics
+
+import (
+	"context"
+	"sort"
+
+	"github.com/GoodCodingFriends/animekai/annict"
+	"github.com/GoodCodingFriends/animekai/api"
+	"github.com/GoodCodingFriends/animekai/resource"
+	"github.com/morikuni/failure"
+)
+
+func (s *service) GetStaffStats(ctx context.Context, req *api.GetStaffStatsRequest) (*api.GetStaffStatsResponse, error) {
+	if err := validateGetStaffStatsRequest(req); err != nil {
+		return nil, failure.Wrap(err)
+	}
+
+	staffs, err := s.annict.ListWorkStaffs(ctx, annict.StatusStateWatched)
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+	return &api.GetStaffStatsResponse{Stats: aggregateStaffStats(staffs, int(req.Limit))}, nil
+}
+
+// aggregateStaffStats counts watched works per staff and role, and returns at most limit entries per role.
+// Staffs are identified by their types and IDs because persons and organizations have separate IDs.
+func aggregateStaffStats(staffs []*resource.Staff, limit int) *resource.StaffStats {
+	type key struct {
+		role resource.Staff_Role
+		typ  resource.Staff_Type
+		id   int32
+	}
+	var (
+		entries = map[key]*resource.StaffStats_Entry{}
+		// works prevents counting a work twice for a staff credited several times in the same role.
+		works  = map[key]map[int32]bool{}
+		byRole = map[resource.Staff_Role][]*resource.StaffStats_Entry{}
+	)
+	for _, st := range staffs {
+		k := key{role: st.Role, typ: st.Type, id: st.Id}
+		e, ok := entries[k]
+		if !ok {
+			e = &resource.StaffStats_Entry{Id: st.Id, Name: st.Name, Type: st.Type}
+			entries[k] = e
+			works[k] = map[int32]bool{}
+			byRole[k.role] = append(byRole[k.role], e)
+		}
+		if works[k][st.WorkId] {
+			continue
+		}
+		works[k][st.WorkId] = true
+		e.WorksCount++
+		e.WorkTitles = append(e.WorkTitles, st.WorkTitle)
+	}
+
+	rank := func(role resource.Staff_Role) []*resource.StaffStats_Entry {
+		es := byRole[role]
+		sort.SliceStable(es, func(i, j int) bool {
+			if es[i].WorksCount != es[j].WorksCount {
+				return es[i].WorksCount > es[j].WorksCount
+			}
+			return es[i].Name < es[j].Name
+		})
+		if len(es) > limit {
+			es = es[:limit]
+		}
+		return es
+	}
+	return &resource.StaffStats{
+		Studios:                  rank(resource.Staff_STUDIO),
+		Directors:                rank(resource.Staff_DIRECTOR),
+		Composers:                rank(resource.Staff_COMPOSER),
+		SeriesCompositionWriters: rank(resource.Staff_SERIES_COMPOSITION),
+	}
+}
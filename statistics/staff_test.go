@@ -0,0 +1,30 @@
+package statistics
+
+import (
+	"testing"
+
+	"github.com/GoodCodingFriends/animekai/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestAggregateStaffStats(t *testing.T) {
+	// The person and the organization have the same ID.
+	staffs := []*resource.Staff{
+		{Id: 1, Name: "person", Type: resource.Staff_PERSON, Role: resource.Staff_COMPOSER, WorkId: 10, WorkTitle: "w10"},
+		{Id: 1, Name: "person", Type: resource.Staff_PERSON, Role: resource.Staff_COMPOSER, WorkId: 11, WorkTitle: "w11"},
+		{Id: 1, Name: "organization", Type: resource.Staff_ORGANIZATION, Role: resource.Staff_COMPOSER, WorkId: 10, WorkTitle: "w10"},
+		// Staffs credited several times in the same role are counted once per work.
+		{Id: 1, Name: "organization", Type: resource.Staff_ORGANIZATION, Role: resource.Staff_COMPOSER, WorkId: 10, WorkTitle: "w10"},
+	}
+
+	stats := aggregateStaffStats(staffs, 10)
+	want := []*resource.StaffStats_Entry{
+		{Id: 1, Name: "person", Type: resource.Staff_PERSON, WorksCount: 2, WorkTitles: []string{"w10", "w11"}},
+		{Id: 1, Name: "organization", Type: resource.Staff_ORGANIZATION, WorksCount: 1, WorkTitles: []string{"w10"}},
+	}
+	opts := cmpopts.IgnoreUnexported(resource.StaffStats_Entry{})
+	if diff := cmp.Diff(want, stats.Composers, opts); diff != "" {
+		t.Errorf("-want, +got\n%s", diff)
+	}
+}
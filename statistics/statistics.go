@@ -2,6 +2,7 @@ package statistics
 
 import (
 	"context"
+	"time"
 
 	"github.com/GoodCodingFriends/animekai/annict"
 	"github.com/GoodCodingFriends/animekai/api"
@@ -16,6 +17,19 @@ type Service interface {
 	GetDashboard(ctx context.Context, req *api.GetDashboardRequest) (*api.GetDashboardResponse, error)
 	// ListWorks returns watching/watched works according to req.
 	ListWorks(ctx context.Context, req *api.ListWorksRequest) (*api.ListWorksResponse, error)
+	// ListReviews returns reviews written by animekai account according to req.
+	ListReviews(ctx context.Context, req *api.ListReviewsRequest) (*api.ListReviewsResponse, error)
+	// ListUpcomingPrograms returns programs of watching works which broadcast unwatched episodes soon.
+	ListUpcomingPrograms(ctx context.Context, req *api.ListUpcomingProgramsRequest) (*api.ListUpcomingProgramsResponse, error)
+	// GetActivity returns the number of recorded episodes per day, week and month.
+	GetActivity(ctx context.Context, req *api.GetActivityRequest) (*api.GetActivityResponse, error)
+	// GetCompletionStats returns how long it took to finish works and how long WATCHING works are dragged out.
+	GetCompletionStats(ctx context.Context, req *api.GetCompletionStatsRequest) (*api.GetCompletionStatsResponse, error)
+	// GetSeasonBreakdown returns the numbers of watching, watched and dropped works per season.
+	GetSeasonBreakdown(ctx context.Context, req *api.GetSeasonBreakdownRequest) (*api.GetSeasonBreakdownResponse, error)
+	// GetStaffStats returns studios, directors, composers and series composition writers ranked by the number of
+	// watched works.
+	GetStaffStats(ctx context.Context, req *api.GetStaffStatsRequest) (*api.GetStaffStatsResponse, error)
 }
 
 type service struct {
@@ -39,6 +53,7 @@ func (s *service) GetDashboard(ctx context.Context, req *api.GetDashboardRequest
 		watchingWorks []*resource.Work
 		watchedWorks  []*resource.Work
 		nextPageToken string
+		streak        *resource.Streak
 
 		eg errgroup.Group
 	)
@@ -68,6 +83,14 @@ func (s *service) GetDashboard(ctx context.Context, req *api.GetDashboardRequest
 		nextPageToken = cursor
 		return nil
 	})
+	eg.Go(func() error {
+		st, err := s.getStreak(ctx, req.StreakGranularity)
+		if err != nil {
+			return failure.Wrap(err)
+		}
+		streak = st
+		return nil
+	})
 	if err := eg.Wait(); err != nil {
 		return nil, failure.Wrap(err)
 	}
@@ -77,6 +100,7 @@ func (s *service) GetDashboard(ctx context.Context, req *api.GetDashboardRequest
 			Profile:       profile,
 			WatchingWorks: watchingWorks,
 			WatchedWorks:  watchedWorks,
+			Streak:        streak,
 		},
 		WorkNextPageToken: nextPageToken,
 	}, nil
@@ -93,6 +117,8 @@ func (s *service) ListWorks(ctx context.Context, req *api.ListWorksRequest) (*ap
 		state = annict.StatusStateWatching
 	case api.WorkState_WATCHED:
 		state = annict.StatusStateWatched
+	case api.WorkState_DROPPED:
+		state = annict.StatusStateStopWatching
 	default:
 		state = annict.StatusStateNoState
 	}
@@ -105,3 +131,30 @@ func (s *service) ListWorks(ctx context.Context, req *api.ListWorksRequest) (*ap
 		NextPageToken: nextPageToken,
 	}, nil
 }
+
+func (s *service) ListReviews(ctx context.Context, req *api.ListReviewsRequest) (*api.ListReviewsResponse, error) {
+	if err := validateListReviewsRequest(req); err != nil {
+		return nil, failure.Wrap(err)
+	}
+
+	reviews, nextPageToken, err := s.annict.ListReviews(ctx, req.PageToken, req.PageSize)
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+	return &api.ListReviewsResponse{
+		Reviews:       reviews,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *service) ListUpcomingPrograms(ctx context.Context, req *api.ListUpcomingProgramsRequest) (*api.ListUpcomingProgramsResponse, error) {
+	if err := validateListUpcomingProgramsRequest(req); err != nil {
+		return nil, failure.Wrap(err)
+	}
+
+	programs, err := s.annict.ListUpcomingPrograms(ctx, time.Duration(req.Hours)*time.Hour)
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+	return &api.ListUpcomingProgramsResponse{Programs: programs}, nil
+}
@@ -0,0 +1,67 @@
+package statistics
+
+import (
+	"context"
+	"time"
+
+	"github.com/GoodCodingFriends/animekai/errors"
+	"github.com/GoodCodingFriends/animekai/resource"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/morikuni/failure"
+)
+
+// getStreak computes streaks from all records until now.
+func (s *service) getStreak(ctx context.Context, granularity resource.Streak_Granularity) (*resource.Streak, error) {
+	now := time.Now()
+	records, err := s.annict.ListRecords(ctx, time.Time{}, now)
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+	st, err := computeStreak(records, granularity, now)
+	if err != nil {
+		return nil, failure.Wrap(err)
+	}
+	return st, nil
+}
+
+// computeStreak computes the current and longest streaks from records in chronological order.
+// If granularity is not specified, streaks are counted by weeks.
+func computeStreak(records []*resource.Record, granularity resource.Streak_Granularity, now time.Time) (*resource.Streak, error) {
+	if granularity == resource.Streak_GRANULARITY_UNSPECIFIED {
+		granularity = resource.Streak_WEEK
+	}
+	start, next := startOfWeek, func(t time.Time) time.Time { return t.AddDate(0, 0, 7) }
+	if granularity == resource.Streak_DAY {
+		start, next = startOfDay, func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }
+	}
+
+	streak := &resource.Streak{Granularity: granularity}
+	var (
+		last time.Time
+		run  int32
+	)
+	for _, r := range records {
+		t, err := ptypes.Timestamp(r.CreateTime)
+		if err != nil {
+			return nil, failure.Translate(err, errors.Internal, failure.Context{"record_id": r.Id})
+		}
+		p := start(t)
+		switch {
+		case p.Equal(last):
+			continue
+		case next(last).Equal(p):
+			run++
+		default:
+			run = 1
+		}
+		last = p
+		if run > streak.Longest {
+			streak.Longest = run
+		}
+	}
+
+	if cur := start(now); last.Equal(cur) || next(last).Equal(cur) {
+		streak.Current = run
+	}
+	return streak, nil
+}
@@ -0,0 +1,56 @@
+package statistics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/GoodCodingFriends/animekai/annict"
+	"github.com/GoodCodingFriends/animekai/resource"
+	"github.com/golang/protobuf/ptypes"
+)
+
+func TestComputeStreak(t *testing.T) {
+	// 2020-07-06 is Monday.
+	date := func(day, hour int) time.Time {
+		return time.Date(2020, 7, day, hour, 0, 0, 0, annict.JST)
+	}
+	times := []time.Time{
+		date(1, 12), date(2, 12), date(2, 23), date(3, 0), // 3 days
+		date(8, 12), date(9, 1), // 2 days
+	}
+	var records []*resource.Record
+	for _, tm := range times {
+		ts, err := ptypes.TimestampProto(tm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, &resource.Record{CreateTime: ts})
+	}
+
+	cases := map[string]struct {
+		granularity resource.Streak_Granularity
+		now         time.Time
+		current     int32
+		longest     int32
+	}{
+		"day":                   {granularity: resource.Streak_DAY, now: date(9, 20), current: 2, longest: 3},
+		"day not recorded yet":  {granularity: resource.Streak_DAY, now: date(10, 20), current: 2, longest: 3},
+		"day broken":            {granularity: resource.Streak_DAY, now: date(11, 0), current: 0, longest: 3},
+		"week":                  {granularity: resource.Streak_WEEK, now: date(12, 23), current: 2, longest: 2},
+		"week broken":           {granularity: resource.Streak_WEEK, now: date(20, 0), current: 0, longest: 2},
+		"unspecified is a week": {now: date(13, 0), current: 2, longest: 2},
+	}
+
+	for name, c := range cases {
+		c := c
+		t.Run(name, func(t *testing.T) {
+			streak, err := computeStreak(records, c.granularity, c.now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if streak.Current != c.current || streak.Longest != c.longest {
+				t.Errorf("expected current and longest streaks are %d and %d, but got %d and %d", c.current, c.longest, streak.Current, streak.Longest)
+			}
+		})
+	}
+}
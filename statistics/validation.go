@@ -22,3 +22,31 @@ func validateListWorksRequest(r *api.ListWorksRequest) error {
 	}
 	return nil
 }
+
+func validateListReviewsRequest(r *api.ListReviewsRequest) error {
+	if r.PageSize <= 0 {
+		return failure.New(errors.InvalidArgument, failure.Message("page_size must be greater than 0"))
+	}
+	return nil
+}
+
+func validateListUpcomingProgramsRequest(r *api.ListUpcomingProgramsRequest) error {
+	if r.Hours <= 0 {
+		return failure.New(errors.InvalidArgument, failure.Message("hours must be greater than 0"))
+	}
+	return nil
+}
+
+func validateGetCompletionStatsRequest(r *api.GetCompletionStatsRequest) error {
+	if r.Limit <= 0 {
+		return failure.New(errors.InvalidArgument, failure.Message("limit must be greater than 0"))
+	}
+	return nil
+}
+
+func validateGetStaffStatsRequest(r *api.GetStaffStatsRequest) error {
+	if r.Limit <= 0 {
+		return failure.New(errors.InvalidArgument, failure.Message("limit must be greater than 0"))
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package store
+
+import (
+	"sync"
+
+	"github.com/GoodCodingFriends/animekai/errors"
+	"github.com/morikuni/failure"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Store is a key-value store which persists cached data across restarts.
+// Keys are grouped by buckets.
+type Store interface {
+	// Get returns the value of key in bucket.
+	// If the key is not found, Get returns an error with errors.NotFound.
+	Get(bucket, key string) ([]byte, error)
+	// Put puts value to key in bucket. If the key already exists, the value is overwritten.
+	Put(bucket, key string, value []byte) error
+	// Delete deletes key in bucket. Delete does nothing if the key is not found.
+	Delete(bucket, key string) error
+	// ForEach calls fn for each key-value pair in bucket.
+	ForEach(bucket string, fn func(key string, value []byte) error) error
+
+	// Close closes the store.
+	Close() error
+}
+
+type boltStore struct {
+	db *bolt.DB
+}
+
+// NewBolt opens the BoltDB file specified by path and returns a Store backed by it.
+// If the file doesn't exist, NewBolt creates it.
+func NewBolt(path string) (Store, error) {
+	db, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		return nil, failure.Translate(err, errors.Internal, failure.Context{"path": path})
+	}
+	return &boltStore{db: db}, nil
+}
+
+func (s *boltStore) Get(bucket, key string) ([]byte, error) {
+	var v []byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(bucket))
+		if b == nil {
+			return failure.New(errors.NotFound)
+		}
+		bv := b.Get([]byte(key))
+		if bv == nil {
+			return failure.New(errors.NotFound)
+		}
+		// bv is only valid while the transaction is open.
+		v = append([]byte(nil), bv...)
+		return nil
+	})
+	if err != nil {
+		return nil, failure.Wrap(err, failure.Context{"bucket": bucket, "key": key})
+	}
+	return v, nil
+}
+
+func (s *boltStore) Put(bucket, key string, value []byte) error {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(bucket))
+		if err != nil {
+			return failure.Translate(err, errors.Internal)
+		}
+		if err := b.Put([]byte(key), value); err != nil {
+			return failure.Translate(err, errors.Internal)
+		}
+		return nil
+	})
+	if err != nil {
+		return failure.Wrap(err, failure.Context{"bucket": bucket, "key": key})
+	}
+	return nil
+}
+
+func (s *boltStore) Delete(bucket, key string) error {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(bucket))
+		if b == nil {
+			return nil
+		}
+		if err := b.Delete([]byte(key)); err != nil {
+			return failure.Translate(err, errors.Internal)
+		}
+		return nil
+	})
+	if err != nil {
+		return failure.Wrap(err, failure.Context{"bucket": bucket, "key": key})
+	}
+	return nil
+}
+
+func (s *boltStore) ForEach(bucket string, fn func(key string, value []byte) error) error {
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(bucket))
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			return fn(string(k), append([]byte(nil), v...))
+		})
+	})
+	if err != nil {
+		return failure.Wrap(err, failure.Context{"bucket": bucket})
+	}
+	return nil
+}
+
+func (s *boltStore) Close() error {
+	if err := s.db.Close(); err != nil {
+		return failure.Translate(err, errors.Internal)
+	}
+	return nil
+}
+
+type memoryStore struct {
+	mu      sync.RWMutex
+	buckets map[string]map[string][]byte
+}
+
+// NewMemory returns a Store which keeps all values in memory.
+// It is useful for testing or environments which don't have a writable file system.
+func NewMemory() Store {
+	return &memoryStore{buckets: map[string]map[string][]byte{}}
+}
+
+func (s *memoryStore) Get(bucket, key string) ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	v, ok := s.buckets[bucket][key]
+	if !ok {
+		return nil, failure.New(errors.NotFound, failure.Context{"bucket": bucket, "key": key})
+	}
+	return append([]byte(nil), v...), nil
+}
+
+func (s *memoryStore) Put(bucket, key string, value []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.buckets[bucket]; !ok {
+		s.buckets[bucket] = map[string][]byte{}
+	}
+	s.buckets[bucket][key] = append([]byte(nil), value...)
+	return nil
+}
+
+func (s *memoryStore) Delete(bucket, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.buckets[bucket], key)
+	return nil
+}
+
+func (s *memoryStore) ForEach(bucket string, fn func(key string, value []byte) error) error {
+	s.mu.RLock()
+	kvs := make(map[string][]byte, len(s.buckets[bucket]))
+	for k, v := range s.buckets[bucket] {
+		kvs[k] = append([]byte(nil), v...)
+	}
+	s.mu.RUnlock()
+
+	for k, v := range kvs {
+		if err := fn(k, v); err != nil {
+			return failure.Wrap(err, failure.Context{"bucket": bucket})
+		}
+	}
+	return nil
+}
+
+func (s *memoryStore) Close() error {
+	return nil
+}
@@ -0,0 +1,79 @@
+package store
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/GoodCodingFriends/animekai/errors"
+	"github.com/morikuni/failure"
+)
+
+func TestStore(t *testing.T) {
+	cases := map[string]func(t *testing.T) Store{
+		"bolt": func(t *testing.T) Store {
+			dir, err := ioutil.TempDir("", "animekai")
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() { os.RemoveAll(dir) })
+
+			s, err := NewBolt(filepath.Join(dir, "animekai.db"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			return s
+		},
+		"memory": func(t *testing.T) Store {
+			return NewMemory()
+		},
+	}
+
+	for name, newStore := range cases {
+		newStore := newStore
+
+		t.Run(name, func(t *testing.T) {
+			s := newStore(t)
+			t.Cleanup(func() {
+				if err := s.Close(); err != nil {
+					t.Errorf("Close should not return an error, but got '%s'", err)
+				}
+			})
+
+			if _, err := s.Get("bucket", "key"); !failure.Is(err, errors.NotFound) {
+				t.Errorf("Get should return NotFound, but got '%v'", err)
+			}
+
+			if err := s.Put("bucket", "key", []byte("value")); err != nil {
+				t.Fatalf("Put should not return an error, but got '%s'", err)
+			}
+			v, err := s.Get("bucket", "key")
+			if err != nil {
+				t.Fatalf("Get should not return an error, but got '%s'", err)
+			}
+			if string(v) != "value" {
+				t.Errorf("expected value is 'value', but got '%s'", string(v))
+			}
+
+			var n int
+			err = s.ForEach("bucket", func(key string, value []byte) error {
+				n++
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("ForEach should not return an error, but got '%s'", err)
+			}
+			if n != 1 {
+				t.Errorf("expected number of keys is 1, but got %d", n)
+			}
+
+			if err := s.Delete("bucket", "key"); err != nil {
+				t.Fatalf("Delete should not return an error, but got '%s'", err)
+			}
+			if _, err := s.Get("bucket", "key"); !failure.Is(err, errors.NotFound) {
+				t.Errorf("Get should return NotFound after Delete, but got '%v'", err)
+			}
+		})
+	}
+}
@@ -26,7 +26,15 @@ func RunAnnictServer(t testing.T, codeDecider map[string]int) (addr string) {
 	}
 	root = strings.TrimSpace(buf.String())
 
-	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	srv := httptest.NewServer(annictHandler(t, codeDecider))
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+// annictHandler responds to queries with fixtures in testdata.
+// If a query contains a key of codeDecider, it responds with the status code instead.
+func annictHandler(t testing.T, codeDecider map[string]int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		b, err := ioutil.ReadAll(r.Body)
 		if err != nil {
 			t.Error(err)
@@ -43,18 +51,31 @@ func RunAnnictServer(t testing.T, codeDecider map[string]int) (addr string) {
 		}
 
 		switch {
+		case strings.Contains(s, "GetWorkEpisodes"):
+			copyFile(t, w, "get_work_episodes_response")
+		case strings.Contains(s, "GetWorkImage"):
+			copyFile(t, w, "get_work_image_response")
 		case strings.Contains(s, "GetWork"):
 			copyFile(t, w, "get_work_response")
 		case strings.Contains(s, "GetProfile"):
 			copyFile(t, w, "get_profile_response")
+		case strings.Contains(s, "ListAllWorks"):
+			copyFile(t, w, "list_all_works_response")
+		case strings.Contains(s, "ListWorkStaffs"):
+			copyFile(t, w, "list_work_staffs_response")
 		case strings.Contains(s, "ListWorks"):
 			copyFile(t, w, "list_works_response")
 		case strings.Contains(s, "listRecords"):
 			copyFile(t, w, "list_records_response")
+		case strings.Contains(s, "ListPrograms"):
+			copyFile(t, w, "list_programs_response")
+		case strings.Contains(s, "ListReviews"):
+			copyFile(t, w, "list_reviews_response")
 		case strings.Contains(s, "ListNextEpisodes"):
 			copyFile(t, w, "list_next_episodes_response")
 		case strings.Contains(s, "CreateRecordMutation"),
-			strings.Contains(s, "UpdateStatusMutation"):
+			strings.Contains(s, "UpdateStatusMutation"),
+			strings.Contains(s, "DeleteRecordMutation"):
 			if _, err := io.WriteString(w, `{"data": {}}`); err != nil {
 				t.Fatal(err)
 			}
@@ -62,10 +83,6 @@ func RunAnnictServer(t testing.T, codeDecider map[string]int) (addr string) {
 			t.Error("unknown query")
 		}
 	})
-
-	srv := httptest.NewServer(h)
-	t.Cleanup(srv.Close)
-	return srv.URL
 }
 
 func copyFile(t testing.T, w io.Writer, fname string) {